@@ -0,0 +1,149 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	pconfig "github.com/prometheus/common/config"
+)
+
+// envRefRE matches ${VAR} references to environment variables
+var envRefRE = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Auth holds the credentials used to log in to the SBC RESTCONF API.
+//
+// The username and password may reference environment variables using the
+// ${VAR} syntax.  The password can also be read from PasswordFile, which is
+// read again every time the configuration is reloaded.
+type Auth struct {
+	Username     string         `yaml:"username,omitempty"`
+	Password     pconfig.Secret `yaml:"password,omitempty"`
+	PasswordFile string         `yaml:"password_file,omitempty"`
+}
+
+// TargetAuth assigns credentials to the targets matching a regular expression.
+type TargetAuth struct {
+	Target Regexp `yaml:"target"`
+	Auth   `yaml:",inline"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *TargetAuth) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain TargetAuth
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	if s.Target.Regexp == nil {
+		return errors.New("target pattern is required for credentials")
+	}
+	if s.Username == "" {
+		return fmt.Errorf("username is required for credentials of target %q", s.Target.Original())
+	}
+	return nil
+}
+
+// load resolves environment variable references and reads the password file.
+// When fromEnv is set and no credentials are configured, the SONUS_USER and
+// SONUS_PASSWORD environment variables are used.
+func (a *Auth) load(fromEnv bool) error {
+	if a.Password != "" && a.PasswordFile != "" {
+		return errors.New("at most one of password and password_file must be configured")
+	}
+	if fromEnv && a.Username == "" && a.Password == "" && a.PasswordFile == "" {
+		a.Username = os.Getenv("SONUS_USER")
+		a.Password = pconfig.Secret(os.Getenv("SONUS_PASSWORD"))
+		return nil
+	}
+
+	var err error
+	if a.Username, err = expandEnv(a.Username); err != nil {
+		return err
+	}
+	if a.PasswordFile != "" {
+		b, err := os.ReadFile(a.PasswordFile)
+		if err != nil {
+			return fmt.Errorf("error reading password file: %s", err)
+		}
+		a.Password = pconfig.Secret(strings.TrimRight(string(b), "\r\n"))
+		return nil
+	}
+	password, err := expandEnv(string(a.Password))
+	if err != nil {
+		return err
+	}
+	a.Password = pconfig.Secret(password)
+	return nil
+}
+
+// expandEnv replaces the ${VAR} references in s with the value of the
+// environment variable.  Referencing an unset variable is an error.
+func expandEnv(s string) (string, error) {
+	var missing []string
+	s = envRefRE.ReplaceAllStringFunc(s, func(ref string) string {
+		name := envRefRE.FindStringSubmatch(ref)[1]
+		val, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return val
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variable(s) not set: %s", strings.Join(missing, ", "))
+	}
+	return s, nil
+}
+
+// Regexp encapsulates a regexp.Regexp and makes it YAML marshalable.
+type Regexp struct {
+	*regexp.Regexp
+	original string
+}
+
+// NewRegexp creates a new anchored Regexp and returns an error if the
+// passed-in regular expression does not compile.
+func NewRegexp(s string) (Regexp, error) {
+	regex, err := regexp.Compile("^(?:" + s + ")$")
+	return Regexp{
+		Regexp:   regex,
+		original: s,
+	}, err
+}
+
+// MustNewRegexp works like NewRegexp, but panics if the regular expression does not compile.
+func MustNewRegexp(s string) Regexp {
+	re, err := NewRegexp(s)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (re *Regexp) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	r, err := NewRegexp(s)
+	if err != nil {
+		return fmt.Errorf("\"Could not compile regular expression\" regexp=\"%s\"", s)
+	}
+	*re = r
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (re Regexp) MarshalYAML() (interface{}, error) {
+	if re.original != "" {
+		return re.original, nil
+	}
+	return nil, nil
+}
+
+// Original returns the original string used to compile the regular expression.
+func (re Regexp) Original() string {
+	return re.original
+}
@@ -14,14 +14,21 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/alecthomas/units"
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+	pconfig "github.com/prometheus/common/config"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -43,7 +50,42 @@ func init() {
 	prometheus.MustRegister(configReloadSeconds)
 }
 
+var (
+	// DefaultModule set default configuration for the Module
+	DefaultModule = Module{
+		TLSConfig: pconfig.TLSConfig{
+			InsecureSkipVerify: true,
+		},
+		AddressContexts: DefaultAddressContexts,
+		Retry:           DefaultRetry,
+		MaxBodySize:     64 * units.MiB,
+		PartialResults:  true,
+		SipConnections:  DefaultSipConnections,
+	}
+
+	// DefaultSipConnections set default configuration for the SIP signaling connection metrics
+	DefaultSipConnections = SipConnections{
+		MaxSeries: 50,
+	}
+
+	// DefaultRetry set default configuration for retrying SBC requests
+	DefaultRetry = Retry{
+		MaxAttempts:    3,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		StatusCodes:    []int{204},
+	}
+
+	// DefaultAddressContexts set default configuration for the address context discovery
+	DefaultAddressContexts = AddressContexts{
+		Discovery: true,
+		Static:    []string{"default"},
+	}
+)
+
 type Config struct {
+	Modules     map[string]Module `yaml:"modules"`
+	Credentials []TargetAuth      `yaml:"credentials,omitempty"`
 }
 
 type SafeConfig struct {
@@ -62,7 +104,32 @@ func (sc *SafeConfig) ReloadConfig(confFile string, logger log.Logger) (err erro
 		}
 	}()
 
-	// load the config here
+	yamlReader, err := os.Open(confFile)
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err)
+	}
+	defer yamlReader.Close()
+	decoder := yaml.NewDecoder(yamlReader)
+	decoder.KnownFields(true)
+
+	if err = decoder.Decode(c); err != nil {
+		return fmt.Errorf("error parsing config file: %s", err)
+	}
+
+	for name, module := range c.Modules {
+		if err = module.Auth.load(true); err != nil {
+			return fmt.Errorf("error loading credentials for module %q: %s", name, err)
+		}
+		if _, err = pconfig.NewTLSConfig(&module.TLSConfig); err != nil {
+			return fmt.Errorf("error loading TLS configuration for module %q: %s", name, err)
+		}
+		c.Modules[name] = module
+	}
+	for i := range c.Credentials {
+		if err = c.Credentials[i].Auth.load(false); err != nil {
+			return fmt.Errorf("error loading credentials for target %q: %s", c.Credentials[i].Target.Original(), err)
+		}
+	}
 
 	sc.Lock()
 	sc.C = c
@@ -80,6 +147,269 @@ func (s *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// AuthFor returns the credentials to use when probing target with the module.
+// The first entry in Credentials matching the target takes precedence over the
+// credentials of the module.
+func (c *Config) AuthFor(module Module, target string) Auth {
+	for _, ta := range c.Credentials {
+		if ta.Target.MatchString(target) {
+			return ta.Auth
+		}
+	}
+	return module.Auth
+}
+
+// Module is a named set of settings used to probe an SBC.
+type Module struct {
+	// Collectors lists the collectors to run. An empty list runs the default collectors.
+	Collectors      []string          `yaml:"collectors,omitempty"`
+	Timeout         time.Duration     `yaml:"timeout,omitempty"`
+	Auth            Auth              `yaml:"auth,omitempty"`
+	TLSConfig       pconfig.TLSConfig `yaml:"tls_config,omitempty"`
+	AddressContexts AddressContexts   `yaml:"address_contexts,omitempty"`
+	Retry           Retry             `yaml:"retry,omitempty"`
+	// Format is the RESTCONF encoding requested from the SBC: xml or json.
+	Format string `yaml:"format,omitempty"`
+	// MaxBodySize is the maximum size of a RESTCONF response. 0 means no limit.
+	MaxBodySize units.Base2Bytes `yaml:"max_body_size,omitempty"`
+	// PartialResults returns the metrics of all collectors when some of them
+	// fail.  When disabled, only the probe metrics are returned on failure.
+	PartialResults bool           `yaml:"partial_results"`
+	TrunkGroupInfo TrunkGroupInfo `yaml:"trunkgroup_info,omitempty"`
+	SipConnections SipConnections `yaml:"sip_connections,omitempty"`
+	// DerivedKPIs publishes the ASR, NER, average call setup time and call
+	// failure ratio of every trunk group, computed from the call statistics.
+	DerivedKPIs bool   `yaml:"derived_kpis,omitempty"`
+	Alarms      Alarms `yaml:"alarms,omitempty"`
+}
+
+// Alarms configures the alarms collector.
+type Alarms struct {
+	// SuppressIDs lists the alarm IDs that are not reported, e.g. noisy
+	// alarms that are not worth alerting on.
+	SuppressIDs []string `yaml:"suppress_ids,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *Alarms) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Alarms
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	for _, id := range s.SuppressIDs {
+		if id == "" {
+			return fmt.Errorf("suppress_ids must not contain an empty alarm ID")
+		}
+	}
+	return nil
+}
+
+// Suppressed reports whether the alarm ID is suppressed.
+func (s Alarms) Suppressed(id string) bool {
+	for _, suppressed := range s.SuppressIDs {
+		if suppressed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// SipConnections configures the metrics of the SIP signaling connections.  The
+// connections are aggregated per peer address unless PerConnection is set, and
+// only the MaxSeries peers or connections of a zone with the most queued PDUs
+// and bytes are exposed.
+type SipConnections struct {
+	PerConnection bool `yaml:"per_connection,omitempty"`
+	// MaxSeries is the maximum number of peers or connections per zone. 0 means no limit.
+	MaxSeries int `yaml:"max_series,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *SipConnections) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*s = DefaultSipConnections
+	type plain SipConnections
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	if s.MaxSeries < 0 {
+		return fmt.Errorf("max_series must not be negative, got %d", s.MaxSeries)
+	}
+	return nil
+}
+
+// TrunkGroupInfoLabels are the optional labels of the sonus_trunkgroup_info
+// metric, taken from the trunk group configuration.
+var TrunkGroupInfoLabels = []string{"carrier", "country", "ip_signaling_profile", "packet_service_profile", "class_of_service"}
+
+// TrunkGroupInfo configures the sonus_trunkgroup_info metric.
+type TrunkGroupInfo struct {
+	// DropLabels lists the labels left out of the metric, e.g. profiles
+	// that change often and would create new series.
+	DropLabels []string `yaml:"drop_labels,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *TrunkGroupInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain TrunkGroupInfo
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	for _, label := range s.DropLabels {
+		if !s.known(label) {
+			return fmt.Errorf("unknown trunkgroup_info label %q, must be one of %s", label, strings.Join(TrunkGroupInfoLabels, ", "))
+		}
+	}
+	return nil
+}
+
+func (s *TrunkGroupInfo) known(label string) bool {
+	for _, l := range TrunkGroupInfoLabels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// Labels returns the optional labels that are not dropped.
+func (s TrunkGroupInfo) Labels() []string {
+	labels := []string{}
+	for _, label := range TrunkGroupInfoLabels {
+		dropped := false
+		for _, d := range s.DropLabels {
+			if d == label {
+				dropped = true
+			}
+		}
+		if !dropped {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// The limits of the retry policy.  A probe is bounded by its timeout anyway,
+// so more attempts or longer delays are most likely a configuration error.
+const (
+	maxRetryAttempts = 10
+	maxRetryBackoff  = time.Minute
+)
+
+// Retry is the policy for retrying SBC requests that returned one of the
+// listed status codes.  The delay between attempts grows exponentially from
+// InitialBackoff up to MaxBackoff, with random jitter.
+type Retry struct {
+	MaxAttempts    int           `yaml:"max_attempts,omitempty"`
+	InitialBackoff time.Duration `yaml:"initial_backoff,omitempty"`
+	MaxBackoff     time.Duration `yaml:"max_backoff,omitempty"`
+	StatusCodes    []int         `yaml:"status_codes,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *Retry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*s = DefaultRetry
+	type plain Retry
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	if s.MaxAttempts < 1 || s.MaxAttempts > maxRetryAttempts {
+		return fmt.Errorf("max_attempts must be between 1 and %d, got %d", maxRetryAttempts, s.MaxAttempts)
+	}
+	if s.InitialBackoff < time.Millisecond {
+		return fmt.Errorf("initial_backoff must be at least 1ms, got %s", s.InitialBackoff)
+	}
+	if s.MaxBackoff < s.InitialBackoff || s.MaxBackoff > maxRetryBackoff {
+		return fmt.Errorf("max_backoff must be between initial_backoff (%s) and %s, got %s", s.InitialBackoff, maxRetryBackoff, s.MaxBackoff)
+	}
+	return nil
+}
+
+// Retryable reports whether a response with the status code should be retried.
+func (s Retry) Retryable(statusCode int) bool {
+	for _, code := range s.StatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// AddressContexts selects the address contexts that are scraped.  When discovery
+// is enabled the address contexts are read from the SBC and filtered with the
+// include and exclude patterns.  The static list is used when discovery is
+// disabled or not permitted for the account.
+type AddressContexts struct {
+	Discovery bool     `yaml:"discovery"`
+	Include   []Regexp `yaml:"include,omitempty"`
+	Exclude   []Regexp `yaml:"exclude,omitempty"`
+	Static    []string `yaml:"static,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *AddressContexts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*s = DefaultAddressContexts
+	type plain AddressContexts
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	if !s.Discovery && len(s.Static) == 0 {
+		return errors.New("static address contexts are required when discovery is disabled")
+	}
+	return nil
+}
+
+// Match reports whether a discovered address context should be scraped.
+func (s AddressContexts) Match(name string) bool {
+	if len(s.Include) > 0 {
+		included := false
+		for _, re := range s.Include {
+			if re.MatchString(name) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, re := range s.Exclude {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *Module) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*s = DefaultModule
+	type plain Module
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	// Certificates are not verified by default, but configuring any TLS
+	// setting, e.g. a CA bundle, implies verification unless
+	// insecure_skip_verify is set explicitly.
+	var raw map[string]interface{}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	tlsConfig, _ := raw["tls_config"].(map[string]interface{})
+	if _, ok := tlsConfig["insecure_skip_verify"]; len(tlsConfig) > 0 && !ok {
+		s.TLSConfig.InsecureSkipVerify = false
+	}
+	if s.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative, got %s", s.Timeout)
+	}
+	if s.Format != "" && s.Format != "xml" && s.Format != "json" {
+		return fmt.Errorf("format must be xml or json, got %q", s.Format)
+	}
+	if s.MaxBodySize < 0 {
+		return fmt.Errorf("max_body_size must not be negative, got %s", s.MaxBodySize)
+	}
+	return nil
+}
+
 // isCompressionAcceptEncodingValid validates the compression +
 // Accept-Encoding combination.
 //
@@ -0,0 +1,200 @@
+// Copyright 2016 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/units"
+	"github.com/go-kit/log"
+)
+
+func TestLoadConfig(t *testing.T) {
+	sc := &SafeConfig{
+		C: &Config{},
+	}
+
+	err := sc.ReloadConfig("testdata/sonus-good.yml", log.NewNopLogger())
+	if err != nil {
+		t.Fatalf("Error loading config %v: %v", "sonus.yml", err)
+	}
+
+	def, ok := sc.C.Modules["default"]
+	if !ok {
+		t.Fatalf("Module %q not loaded", "default")
+	}
+	if def.Timeout != 30*time.Second {
+		t.Errorf("Expected timeout 30s, got %s", def.Timeout)
+	}
+	if !def.TLSConfig.InsecureSkipVerify {
+		t.Errorf("Expected default module to skip TLS verification")
+	}
+
+	hw := sc.C.Modules["hardware"]
+	if len(hw.Collectors) != 3 {
+		t.Errorf("Expected 3 collectors, got %v", hw.Collectors)
+	}
+	if hw.Auth.Username != "monitor" || string(hw.Auth.Password) != "secret" {
+		t.Errorf("Unexpected credentials: %v", hw.Auth)
+	}
+	if hw.TLSConfig.InsecureSkipVerify {
+		t.Errorf("Expected insecure_skip_verify to be overridden")
+	}
+	if sc.C.Modules["verified"].TLSConfig.InsecureSkipVerify {
+		t.Errorf("Expected a module with tls_config settings to verify certificates")
+	}
+	if hw.Format != "json" || hw.MaxBodySize != 16*units.MiB {
+		t.Errorf("Expected json format and 16MiB body limit, got %q and %s", hw.Format, hw.MaxBodySize)
+	}
+	if def.MaxBodySize != DefaultModule.MaxBodySize {
+		t.Errorf("Expected default max_body_size %s, got %s", DefaultModule.MaxBodySize, def.MaxBodySize)
+	}
+	if !hw.AddressContexts.Discovery || len(hw.AddressContexts.Static) != 1 {
+		t.Errorf("Expected default address context settings, got %+v", hw.AddressContexts)
+	}
+
+	want := []string{"carrier", "country", "ip_signaling_profile", "class_of_service"}
+	if got := sc.C.Modules["core"].TrunkGroupInfo.Labels(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected trunkgroup_info labels %v, got %v", want, got)
+	}
+	if got := def.TrunkGroupInfo.Labels(); len(got) != len(TrunkGroupInfoLabels) {
+		t.Errorf("Expected all trunkgroup_info labels by default, got %v", got)
+	}
+
+	if got := sc.C.Modules["core"].SipConnections; !got.PerConnection || got.MaxSeries != 10 {
+		t.Errorf("Expected per connection sip_connections with max_series 10, got %+v", got)
+	}
+	if !sc.C.Modules["core"].DerivedKPIs || def.DerivedKPIs {
+		t.Errorf("Expected derived_kpis only for the core module")
+	}
+	if alarms := sc.C.Modules["core"].Alarms; !alarms.Suppressed("2120") || alarms.Suppressed("1050") {
+		t.Errorf("Expected alarms 1049 and 2120 to be suppressed, got %v", alarms.SuppressIDs)
+	}
+	if got := def.SipConnections; got != DefaultSipConnections {
+		t.Errorf("Expected default sip_connections %+v, got %+v", DefaultSipConnections, got)
+	}
+
+	core := sc.C.Modules["core"].AddressContexts
+	for name, want := range map[string]bool{"core": true, "core_a": true, "core_test": false, "default": false} {
+		if got := core.Match(name); got != want {
+			t.Errorf("Match(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestLoadBadConfigs(t *testing.T) {
+	sc := &SafeConfig{
+		C: &Config{},
+	}
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "testdata/invalid-field.yml",
+			want:  "field colectors not found",
+		},
+		{
+			input: "testdata/invalid-timeout.yml",
+			want:  "timeout must not be negative",
+		},
+		{
+			input: "testdata/invalid-password-file.yml",
+			want:  "at most one of password and password_file must be configured",
+		},
+		{
+			input: "testdata/invalid-env-ref.yml",
+			want:  "environment variable(s) not set: SONUS_TEST_UNSET_VARIABLE",
+		},
+		{
+			input: "testdata/invalid-address-contexts.yml",
+			want:  "static address contexts are required when discovery is disabled",
+		},
+		{
+			input: "testdata/invalid-retry.yml",
+			want:  "max_attempts must be between 1 and 10, got 0",
+		},
+		{
+			input: "testdata/invalid-retry-backoff.yml",
+			want:  "initial_backoff must be at least 1ms",
+		},
+		{
+			input: "testdata/invalid-format.yml",
+			want:  `format must be xml or json, got "yaml"`,
+		},
+		{
+			input: "testdata/invalid-max-body-size.yml",
+			want:  "max_body_size must not be negative",
+		},
+		{
+			input: "testdata/invalid-trunkgroup-info.yml",
+			want:  `unknown trunkgroup_info label "carier"`,
+		},
+		{
+			input: "testdata/invalid-sip-connections.yml",
+			want:  "max_series must not be negative",
+		},
+		{
+			input: "testdata/invalid-alarms.yml",
+			want:  "suppress_ids must not contain an empty alarm ID",
+		},
+		{
+			input: "testdata/does-not-exist.yml",
+			want:  "error reading config file",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			got := sc.ReloadConfig(test.input, log.NewNopLogger())
+			if got == nil || !strings.Contains(got.Error(), test.want) {
+				t.Fatalf("ReloadConfig(%q) = %v; want error containing %q", test.input, got, test.want)
+			}
+		})
+	}
+}
+
+func TestAuthFor(t *testing.T) {
+	t.Setenv("SONUS_TEST_USER", "monitor")
+	t.Setenv("SONUS_TEST_LAB_PASSWORD", "labsecret")
+	t.Setenv("SONUS_USER", "envuser")
+	t.Setenv("SONUS_PASSWORD", "envpassword")
+
+	sc := &SafeConfig{
+		C: &Config{},
+	}
+	if err := sc.ReloadConfig("testdata/sonus-credentials.yml", log.NewNopLogger()); err != nil {
+		t.Fatalf("Error loading config: %v", err)
+	}
+
+	tests := []struct {
+		module   string
+		target   string
+		username string
+		password string
+	}{
+		{module: "default", target: "sbc01.example.com", username: "monitor", password: "fromfile"},
+		{module: "default", target: "lab-sbc01", username: "lab", password: "labsecret"},
+		{module: "env", target: "10.1.2.3", username: "customer", password: "plain"},
+		{module: "env", target: "10.1.2.30", username: "envuser", password: "envpassword"},
+	}
+	for _, test := range tests {
+		auth := sc.C.AuthFor(sc.C.Modules[test.module], test.target)
+		if auth.Username != test.username || string(auth.Password) != test.password {
+			t.Errorf("AuthFor(%q, %q) = %s/%s; want %s/%s", test.module, test.target,
+				auth.Username, string(auth.Password), test.username, test.password)
+		}
+	}
+}
@@ -12,6 +12,7 @@ require (
 
 require (
 	github.com/alecthomas/kingpin/v2 v2.3.1 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/prometheus/client_model v0.3.0 // indirect
 	github.com/xhit/go-str2duration v1.2.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
@@ -19,7 +20,7 @@ require (
 
 require (
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751 // indirect
-	github.com/alecthomas/units v0.0.0-20211218093645-b94a6e3cc137 // indirect
+	github.com/alecthomas/units v0.0.0-20211218093645-b94a6e3cc137
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.1.2 // indirect
 	github.com/coreos/go-systemd/v22 v22.4.0 // indirect
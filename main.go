@@ -54,6 +54,8 @@ var (
 	historyLimit  = kingpin.Flag("history.limit", "The maximum amount of items to keep in the history.").Default("100").Uint()
 	externalURL   = kingpin.Flag("web.external-url", "The URL under which sonus exporter is externally reachable (for example, if sonus exporter is served via a reverse proxy). Used for generating relative and absolute links back to sonus exporter itself. If the URL has a path portion, it will be used to prefix all HTTP endpoints served by sonus exporter. If omitted, relevant URL components will be derived automatically.").PlaceHolder("<url>").String()
 	routePrefix   = kingpin.Flag("web.route-prefix", "Prefix for the internal routes of web endpoints. Defaults to path of --web.external-url.").PlaceHolder("<path>").String()
+	cacheRefresh  = kingpin.Flag("sbc.cache-refresh", "How long the system information and address contexts of an SBC are reused between probes. 0 disables the SBC cache.").Default("5m").Duration()
+	cacheIdle     = kingpin.Flag("sbc.cache-idle-timeout", "Evict SBCs from the cache that have not been probed for this long.").Default("15m").Duration()
 	toolkitFlags  = webflag.AddFlags(kingpin.CommandLine, ":9700")
 )
 
@@ -67,13 +69,18 @@ func main() {
 
 func run() int {
 	kingpin.CommandLine.UsageWriter(os.Stdout)
-	promlogConfig := &promlog.Config{}
-	flag.AddFlags(kingpin.CommandLine, promlogConfig)
+	promlogConfig := &promlog.Config{
+		Level:  &promlog.AllowedLevel{},
+		Format: &promlog.AllowedFormat{},
+	}
+	kingpin.Flag(flag.LevelFlagName, flag.LevelFlagHelp).Default("info").SetValue(promlogConfig.Level)
+	kingpin.Flag(flag.FormatFlagName, flag.FormatFlagHelp).Default("logfmt").SetValue(promlogConfig.Format)
 	kingpin.Version(version.Print("sonus_exporter"))
 	kingpin.HelpFlag.Short('h')
 	kingpin.Parse()
 	logger := promlog.New(promlogConfig)
 	rh := &prober.ResultHistory{MaxResults: *historyLimit}
+	cache := prober.NewSBCCache(*cacheRefresh, *cacheIdle)
 
 	level.Info(logger).Log("msg", "Starting sonus_exporter", "version", version.Info())
 	level.Info(logger).Log("build_context", version.BuildContext())
@@ -178,7 +185,7 @@ func run() int {
 		sc.Lock()
 		conf := sc.C
 		sc.Unlock()
-		prober.Handler(w, r, conf, logger, rh, *timeoutOffset, nil)
+		prober.Handler(w, r, conf, logger, rh, *timeoutOffset, cache, nil)
 	})
 	http.HandleFunc(*routePrefix, func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/html")
@@ -186,8 +193,8 @@ func run() int {
     <head><title>sonus Exporter</title></head>
     <body>
     <h1>sonus Exporter</h1>
-    <p><a href="probe?target=prometheus.io&module=http_2xx">Probe prometheus.io for http_2xx</a></p>
-    <p><a href="probe?target=prometheus.io&module=http_2xx&debug=true">Debug probe prometheus.io for http_2xx</a></p>
+    <p><a href="probe?target=sbc.example.com&module=default">Probe sbc.example.com using the default module</a></p>
+    <p><a href="probe?target=sbc.example.com&module=default&debug=true">Debug probe sbc.example.com using the default module</a></p>
     <p><a href="metrics">Metrics</a></p>
     <p><a href="config">Configuration</a></p>
     <h2>Recent Probes</h2>
@@ -0,0 +1,171 @@
+package prober
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+	"github.com/ringsq/sonus_exporter/sonus"
+)
+
+var (
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_cache_requests_total",
+		Help:      "Number of SBC client cache lookups, by result.",
+	}, []string{"result"})
+
+	cacheEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_cache_evictions_total",
+		Help:      "Number of SBC clients evicted from the cache because they were idle.",
+	})
+
+	cacheEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_cache_entries",
+		Help:      "Number of SBC clients currently cached.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(cacheRequests)
+	prometheus.MustRegister(cacheEvictions)
+	prometheus.MustRegister(cacheEntries)
+}
+
+type cacheKey struct {
+	module string
+	target string
+}
+
+type cacheEntry struct {
+	sbc      *sonus.SBC
+	auth     config.Auth
+	created  time.Time
+	lastUsed time.Time
+}
+
+type moduleClient struct {
+	module config.Module
+	client *http.Client
+}
+
+// SBCCache keeps SBC clients between probes, keyed by target and module.
+// The system information and address contexts of a cached SBC are read again
+// once they are older than the refresh interval, and SBCs that have not been
+// probed within the idle timeout are evicted.  All SBCs probed with the same
+// module share an HTTP client, so keep-alive connections and TLS sessions are
+// reused between scrapes.
+type SBCCache struct {
+	mu          sync.Mutex
+	refresh     time.Duration
+	idleTimeout time.Duration
+	lastSweep   time.Time
+	entries     map[cacheKey]*cacheEntry
+	clients     map[string]*moduleClient
+}
+
+// NewSBCCache creates an empty cache.  A refresh interval of zero disables
+// caching: NewSBCCache returns nil, and Handler creates a new SBC for every probe.
+func NewSBCCache(refresh, idleTimeout time.Duration) *SBCCache {
+	if refresh <= 0 {
+		return nil
+	}
+	return &SBCCache{
+		refresh:     refresh,
+		idleTimeout: idleTimeout,
+		lastSweep:   time.Now(),
+		entries:     map[cacheKey]*cacheEntry{},
+		clients:     map[string]*moduleClient{},
+	}
+}
+
+// Get returns the SBC for the target, creating it when it is not cached, its
+// settings changed, or the cached information is older than the refresh interval.
+func (c *SBCCache) Get(ctx context.Context, moduleName string, module config.Module, auth config.Auth, target string) (*sonus.SBC, error) {
+	key := cacheKey{module: moduleName, target: target}
+	now := time.Now()
+
+	c.mu.Lock()
+	c.sweep(now)
+	client, err := c.client(moduleName, module)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	if e, ok := c.entries[key]; ok && e.auth == auth && now.Sub(e.created) < c.refresh {
+		e.lastUsed = now
+		c.mu.Unlock()
+		cacheRequests.WithLabelValues("hit").Inc()
+		return e.sbc, nil
+	}
+	c.mu.Unlock()
+	cacheRequests.WithLabelValues("miss").Inc()
+
+	sbc, err := sonus.NewSBCWithClient(ctx, target, auth.Username, string(auth.Password), client, module)
+	if err != nil {
+		c.Invalidate(moduleName, target)
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = &cacheEntry{sbc: sbc, auth: auth, created: now, lastUsed: now}
+	cacheEntries.Set(float64(len(c.entries)))
+	c.mu.Unlock()
+	return sbc, nil
+}
+
+// Invalidate removes the SBC for the target so that the next probe reads the
+// system information again.
+func (c *SBCCache) Invalidate(moduleName, target string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, cacheKey{module: moduleName, target: target})
+	cacheEntries.Set(float64(len(c.entries)))
+}
+
+// client returns the shared HTTP client for the module.  A new client is
+// created when the module settings changed, e.g. after a configuration reload.
+// Must be called with c.mu held.
+func (c *SBCCache) client(moduleName string, module config.Module) (*http.Client, error) {
+	mc, ok := c.clients[moduleName]
+	if ok && reflect.DeepEqual(mc.module, module) {
+		return mc.client, nil
+	}
+	client, err := sonus.NewClient(module)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		mc.client.CloseIdleConnections()
+		for key := range c.entries {
+			if key.module == moduleName {
+				delete(c.entries, key)
+			}
+		}
+		cacheEntries.Set(float64(len(c.entries)))
+	}
+	c.clients[moduleName] = &moduleClient{module: module, client: client}
+	return client, nil
+}
+
+// sweep evicts the SBCs that have not been used within the idle timeout.
+// Must be called with c.mu held.
+func (c *SBCCache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.idleTimeout/2 {
+		return
+	}
+	c.lastSweep = now
+	for key, e := range c.entries {
+		if now.Sub(e.lastUsed) > c.idleTimeout {
+			delete(c.entries, key)
+			cacheEvictions.Inc()
+		}
+	}
+	cacheEntries.Set(float64(len(c.entries)))
+}
@@ -0,0 +1,75 @@
+package prober
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+func newTestSBCServer(t *testing.T, calls *int32) string {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/admin") {
+			atomic.AddInt32(calls, 1)
+		}
+		w.Write([]byte(`<collection><admin><name>testsbc</name></admin></collection>`))
+	}))
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "https://")
+}
+
+func TestSBCCache(t *testing.T) {
+	var calls int32
+	target := newTestSBCServer(t, &calls)
+	module := config.DefaultModule
+	auth := config.Auth{Username: "user", Password: "password"}
+	ctx := context.Background()
+
+	cache := NewSBCCache(time.Minute, time.Hour)
+	first, err := cache.Get(ctx, "default", module, auth, target)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	second, err := cache.Get(ctx, "default", module, auth, target)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if first != second {
+		t.Errorf("Get() returned a new SBC for a cached target")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("SBC called %d times, want 1", n)
+	}
+
+	// Changed credentials must not reuse the cached SBC.
+	auth.Password = "changed"
+	if third, _ := cache.Get(ctx, "default", module, auth, target); third == first {
+		t.Errorf("Get() reused the SBC after the credentials changed")
+	}
+
+	// Changed module settings must not reuse the cached SBC.
+	module.Timeout = time.Second
+	fourth, _ := cache.Get(ctx, "default", module, auth, target)
+	if fifth, _ := cache.Get(ctx, "default", module, auth, target); fifth != fourth {
+		t.Errorf("Get() returned a new SBC for a cached target")
+	}
+
+	cache.Invalidate("default", target)
+	if sixth, _ := cache.Get(ctx, "default", module, auth, target); sixth == fourth {
+		t.Errorf("Get() reused an invalidated SBC")
+	}
+	if n := atomic.LoadInt32(&calls); n != 4 {
+		t.Errorf("SBC called %d times, want 4", n)
+	}
+}
+
+func TestSBCCacheDisabled(t *testing.T) {
+	if cache := NewSBCCache(0, time.Hour); cache != nil {
+		t.Errorf("NewSBCCache(0) = %v, want nil", cache)
+	}
+}
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
 	"time"
 
@@ -32,37 +31,36 @@ import (
 	"github.com/ringsq/sonus_exporter/config"
 	"github.com/ringsq/sonus_exporter/sonus"
 	"golang.org/x/sync/errgroup"
-)
-
-var (
-	user     = os.Getenv("SONUS_USER")
-	password = os.Getenv("SONUS_PASSWORD")
-)
-
-var (
-	Probers = []ProbeFn{
-		sonus.ZoneProbe,
-		sonus.ServerInfoMetrics,
-		sonus.FanMetrics,
-		sonus.PowerMetrics,
-		sonus.DSPMetrics,
-	}
+	"gopkg.in/yaml.v3"
 )
 
 func Handler(w http.ResponseWriter, r *http.Request, c *config.Config, logger log.Logger,
-	rh *ResultHistory, timeoutOffset float64,
+	rh *ResultHistory, timeoutOffset float64, cache *SBCCache,
 	params url.Values) {
 
 	if params == nil {
 		params = r.URL.Query()
 	}
-	// moduleName := params.Get("module")
-	// if moduleName == "" {
-	// 	moduleName = "http_2xx"
-	// }
+	moduleName := params.Get("module")
+	if moduleName == "" {
+		moduleName = "default"
+	}
+	module, ok := c.Modules[moduleName]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown module %q", moduleName), http.StatusBadRequest)
+		level.Debug(logger).Log("msg", "Unknown module", "module", moduleName)
+		return
+	}
+
+	probers, err := selectProbers(module, params["collect[]"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		level.Debug(logger).Log("msg", "Invalid collector selection", "module", moduleName, "err", err)
+		return
+	}
 
 	success := false
-	timeoutSeconds, err := getTimeout(r, timeoutOffset)
+	timeoutSeconds, err := getTimeout(r, module, timeoutOffset)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to parse timeout from Prometheus header: %s", err), http.StatusInternalServerError)
 		return
@@ -80,6 +78,22 @@ func Handler(w http.ResponseWriter, r *http.Request, c *config.Config, logger lo
 		Name: "probe_duration_seconds",
 		Help: "Returns how long the probe took to complete in seconds",
 	})
+	probeTLSVerifyFailedGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "probe_tls_verify_failed",
+		Help: "Indicates if the probe failed because the SBC certificate could not be verified",
+	})
+	probeErrorTypeGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "probe_error_type",
+		Help: "Indicates the type of the errors that failed the probe",
+	}, []string{"type"})
+	probeCollectorSuccessGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "probe_collector_success",
+		Help: "Displays whether or not the collector was a success",
+	}, []string{"collector"})
+	probeCollectorDurationGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "probe_collector_duration_seconds",
+		Help: "Returns how long the collector took to complete in seconds",
+	}, []string{"collector"})
 
 	target := params.Get("target")
 	if target == "" {
@@ -88,25 +102,68 @@ func Handler(w http.ResponseWriter, r *http.Request, c *config.Config, logger lo
 	}
 
 	start := time.Now()
-	sbc := sonus.NewSBC(target, user, password)
-
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(probeSuccessGauge)
 	registry.MustRegister(probeDurationGauge)
+	registry.MustRegister(probeTLSVerifyFailedGauge)
+	registry.MustRegister(probeErrorTypeGauge)
+	registry.MustRegister(probeCollectorSuccessGauge)
+	registry.MustRegister(probeCollectorDurationGauge)
+	// The collector metrics are kept apart so they can be dropped when partial
+	// results are disabled.
+	collectorRegistry := prometheus.NewRegistry()
+	var gatherer prometheus.Gatherer = prometheus.Gatherers{registry, collectorRegistry}
 
-	sl := newScrapeLogger(logger, "test", target)
-	if sbc != nil {
+	sl := newScrapeLogger(logger, moduleName, target)
+	auth := c.AuthFor(module, target)
+	var sbc *sonus.SBC
+	if cache != nil {
+		sbc, err = cache.Get(ctx, moduleName, module, auth, target)
+	} else {
+		sbc, err = sonus.NewSBC(ctx, target, auth.Username, string(auth.Password), module)
+	}
+	if err != nil {
+		level.Error(sl).Log("msg", "Error connecting to SBC", "err", err, "error_type", sonus.ErrorType(err))
+		probeErrorTypeGauge.WithLabelValues(sonus.ErrorType(err)).Set(1)
+		if sonus.IsTLSVerifyError(err) {
+			probeTLSVerifyFailedGauge.Set(1)
+		}
+		for name := range probers {
+			probeCollectorSuccessGauge.WithLabelValues(name).Set(0)
+		}
+	} else {
 		golog.Infof("Starting probe of %s", target)
 		g := &errgroup.Group{}
-		level.Info(sl).Log("msg", "Beginning probe", "probe", "test", "timeout_seconds", timeoutSeconds)
-		for _, probe := range Probers {
-			probe := probe
+		level.Info(sl).Log("msg", "Beginning probe", "probe", moduleName, "timeout_seconds", timeoutSeconds)
+		for name, probe := range probers {
+			name, probe := name, probe
 			g.Go(func() error {
-				return probe(ctx, sbc, c, registry, sl)
+				collectorStart := time.Now()
+				err := probe(ctx, sbc, module, collectorRegistry, sl)
+				probeCollectorDurationGauge.WithLabelValues(name).Set(time.Since(collectorStart).Seconds())
+				if err != nil {
+					level.Error(sl).Log("msg", "Collector failed", "collector", name, "err", err, "error_type", sonus.ErrorType(err))
+					probeErrorTypeGauge.WithLabelValues(sonus.ErrorType(err)).Set(1)
+					if sonus.IsTLSVerifyError(err) {
+						probeTLSVerifyFailedGauge.Set(1)
+					}
+					probeCollectorSuccessGauge.WithLabelValues(name).Set(0)
+					// Only drop the cached SBC when the session itself failed; an
+					// endpoint that is missing on this SBC will not come back.
+					if cache != nil && sonus.IsSessionError(err) {
+						cache.Invalidate(moduleName, target)
+					}
+					return fmt.Errorf("collector %s: %w", name, err)
+				}
+				probeCollectorSuccessGauge.WithLabelValues(name).Set(1)
+				return nil
 			})
 		}
 		if err := g.Wait(); err != nil {
 			level.Error(sl).Log("msg", "Probe failed", "err", err)
+			if !module.PartialResults {
+				gatherer = registry
+			}
 		} else {
 			probeSuccessGauge.Set(1)
 			level.Info(sl).Log("msg", "Probe succeeded")
@@ -119,8 +176,8 @@ func Handler(w http.ResponseWriter, r *http.Request, c *config.Config, logger lo
 	probeDurationGauge.Set(duration)
 	level.Info(sl).Log("duration_seconds", duration)
 
-	debugOutput := DebugOutput(&sl.buffer, registry)
-	rh.Add("test", target, debugOutput, success)
+	debugOutput := DebugOutput(&module, &sl.buffer, gatherer)
+	rh.Add(moduleName, target, debugOutput, success)
 
 	if r.URL.Query().Get("debug") == "true" {
 		w.Header().Set("Content-Type", "text/plain")
@@ -128,7 +185,7 @@ func Handler(w http.ResponseWriter, r *http.Request, c *config.Config, logger lo
 		return
 	}
 
-	h := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	h := promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})
 	h.ServeHTTP(w, r)
 }
 
@@ -163,7 +220,7 @@ func (sl scrapeLogger) Log(keyvals ...interface{}) error {
 }
 
 // DebugOutput returns plaintext debug output for a probe.
-func DebugOutput(logBuffer *bytes.Buffer, registry *prometheus.Registry) string {
+func DebugOutput(module *config.Module, logBuffer *bytes.Buffer, registry prometheus.Gatherer) string {
 	buf := &bytes.Buffer{}
 	fmt.Fprintf(buf, "Logs for the probe:\n")
 	logBuffer.WriteTo(buf)
@@ -175,17 +232,49 @@ func DebugOutput(logBuffer *bytes.Buffer, registry *prometheus.Registry) string
 	for _, mf := range mfs {
 		expfmt.MetricFamilyToText(buf, mf)
 	}
-	// fmt.Fprintf(buf, "\n\n\nModule configuration:\n")
-	// c, err := yaml.Marshal(module)
-	// if err != nil {
-	// 	fmt.Fprintf(buf, "Error marshalling config: %s\n", err)
-	// }
-	// buf.Write(c)
+	fmt.Fprintf(buf, "\n\n\nModule configuration:\n")
+	c, err := yaml.Marshal(module)
+	if err != nil {
+		fmt.Fprintf(buf, "Error marshalling config: %s\n", err)
+	}
+	buf.Write(c)
 
 	return buf.String()
 }
 
-func getTimeout(r *http.Request, offset float64) (timeoutSeconds float64, err error) {
+// selectProbers returns the probers for the collectors requested with collect[].
+// Without collect[], the collectors named in the module are used, or the
+// default collectors when the module does not list any.  Requested collectors
+// must be enabled in the module.
+func selectProbers(module config.Module, collect []string) (map[string]ProbeFn, error) {
+	names := module.Collectors
+	if len(names) == 0 {
+		names = sonus.DefaultCollectorNames()
+	}
+	if len(collect) > 0 {
+		enabled := make(map[string]bool, len(names))
+		for _, name := range names {
+			enabled[name] = true
+		}
+		for _, name := range collect {
+			if _, ok := sonus.LookupCollector(name); ok && !enabled[name] {
+				return nil, fmt.Errorf("collector %q is not enabled in the module", name)
+			}
+		}
+		names = collect
+	}
+	probers := make(map[string]ProbeFn, len(names))
+	for _, name := range names {
+		probe, ok := sonus.LookupCollector(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown collector %q, must be one of %v", name, sonus.CollectorNames())
+		}
+		probers[name] = probe
+	}
+	return probers, nil
+}
+
+func getTimeout(r *http.Request, module config.Module, offset float64) (timeoutSeconds float64, err error) {
 	// If a timeout is configured via the Prometheus header, add it to the request.
 	if v := r.Header.Get("X-Prometheus-Scrape-Timeout-Seconds"); v != "" {
 		var err error
@@ -199,7 +288,11 @@ func getTimeout(r *http.Request, offset float64) (timeoutSeconds float64, err er
 	}
 
 	var maxTimeoutSeconds = timeoutSeconds - offset
-	timeoutSeconds = maxTimeoutSeconds
+	if module.Timeout.Seconds() < maxTimeoutSeconds && module.Timeout.Seconds() > 0 || maxTimeoutSeconds < 0 {
+		timeoutSeconds = module.Timeout.Seconds()
+	} else {
+		timeoutSeconds = maxTimeoutSeconds
+	}
 
 	return timeoutSeconds, nil
 }
@@ -0,0 +1,193 @@
+package prober
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+	"github.com/ringsq/sonus_exporter/sonus"
+)
+
+func init() {
+	sonus.RegisterCollector("good", func(ctx context.Context, sbc *sonus.SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+		g := prometheus.NewGauge(prometheus.GaugeOpts{Name: "sonus_test_value", Help: "Test value"})
+		registry.MustRegister(g)
+		g.Set(42)
+		return nil
+	})
+	sonus.RegisterCollector("bad", func(ctx context.Context, sbc *sonus.SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+		return errors.New("endpoint failed")
+	})
+	sonus.RegisterCollector("untrusted", func(ctx context.Context, sbc *sonus.SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+		// Fail after the bad collector, so that its error is the probe error.
+		time.Sleep(10 * time.Millisecond)
+		return fmt.Errorf("get system: %w", x509.UnknownAuthorityError{})
+	})
+	sonus.RegisterOptionalCollector("optional", func(ctx context.Context, sbc *sonus.SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+		return nil
+	})
+	sonus.RegisterCollector("notfound", func(ctx context.Context, sbc *sonus.SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+		return &sonus.RestconfError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	})
+	sonus.RegisterCollector("unauthorized", func(ctx context.Context, sbc *sonus.SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+		return &sonus.RestconfError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"}
+	})
+}
+
+func probe(t *testing.T, c *config.Config, query string) string {
+	t.Helper()
+	return probeCached(t, c, query, nil)
+}
+
+func probeCached(t *testing.T, c *config.Config, query string, cache *SBCCache) string {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/probe?"+query, nil)
+	rr := httptest.NewRecorder()
+	Handler(rr, req, c, log.NewNopLogger(), &ResultHistory{MaxResults: 10}, 0.5, cache, nil)
+	return rr.Body.String()
+}
+
+func TestHandlerCollectorResults(t *testing.T) {
+	var calls int32
+	target := newTestSBCServer(t, &calls)
+
+	partial := config.DefaultModule
+	partial.Collectors = []string{"good", "bad"}
+	strict := partial
+	strict.PartialResults = false
+	c := &config.Config{Modules: map[string]config.Module{"partial": partial, "strict": strict}}
+
+	body := probe(t, c, "module=partial&target="+target)
+	for _, want := range []string{
+		`probe_success 0`,
+		`probe_collector_success{collector="good"} 1`,
+		`probe_collector_success{collector="bad"} 0`,
+		`probe_collector_duration_seconds{collector="bad"}`,
+		`sonus_test_value 42`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("partial probe output does not contain %q:\n%s", want, body)
+		}
+	}
+
+	body = probe(t, c, "module=strict&target="+target)
+	if !strings.Contains(body, `probe_collector_success{collector="good"} 1`) {
+		t.Errorf("strict probe output does not contain the collector results:\n%s", body)
+	}
+	if strings.Contains(body, "sonus_test_value") {
+		t.Errorf("strict probe output contains collector metrics after a failure:\n%s", body)
+	}
+}
+
+func TestHandlerUnknownModule(t *testing.T) {
+	c := &config.Config{Modules: map[string]config.Module{"default": config.DefaultModule}}
+	req := httptest.NewRequest(http.MethodGet, "/probe?module=missing&target=sbc", nil)
+	rr := httptest.NewRecorder()
+	Handler(rr, req, c, log.NewNopLogger(), &ResultHistory{MaxResults: 10}, 0.5, nil, nil)
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("Handler() status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlerCollectParam(t *testing.T) {
+	var calls int32
+	target := newTestSBCServer(t, &calls)
+
+	module := config.DefaultModule
+	module.Collectors = []string{"good", "bad"}
+	c := &config.Config{Modules: map[string]config.Module{"default": module}}
+
+	body := probe(t, c, "collect[]=good&target="+target)
+	if !strings.Contains(body, "probe_success 1") || strings.Contains(body, `collector="bad"`) {
+		t.Errorf("probe output with collect[]=good:\n%s", body)
+	}
+
+	for query, want := range map[string]string{
+		"collect[]=good&collect[]=missing": `unknown collector "missing"`,
+		"collect[]=zones":                  `collector "zones" is not enabled in the module`,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/probe?target=sbc&"+query, nil)
+		rr := httptest.NewRecorder()
+		Handler(rr, req, c, log.NewNopLogger(), &ResultHistory{MaxResults: 10}, 0.5, nil, nil)
+		if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), want) {
+			t.Errorf("Handler(%s) = %d %q, want %d %q", query, rr.Code, rr.Body.String(), http.StatusBadRequest, want)
+		}
+	}
+}
+
+func TestHandlerCacheInvalidation(t *testing.T) {
+	var calls int32
+	target := newTestSBCServer(t, &calls)
+
+	missing := config.DefaultModule
+	missing.Collectors = []string{"good", "notfound"}
+	unauthorized := config.DefaultModule
+	unauthorized.Collectors = []string{"good", "unauthorized"}
+	c := &config.Config{Modules: map[string]config.Module{"missing": missing, "unauthorized": unauthorized}}
+	cache := NewSBCCache(time.Minute, time.Hour)
+
+	// An endpoint missing on the SBC fails the collector, not the session.
+	for i := 0; i < 2; i++ {
+		body := probeCached(t, c, "module=missing&target="+target, cache)
+		if !strings.Contains(body, `probe_collector_success{collector="notfound"} 0`) {
+			t.Errorf("probe output does not contain the failed collector:\n%s", body)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("SBC created %d times after a not found error, want 1", n)
+	}
+
+	// Rejected credentials drop the cached SBC.
+	for i := 0; i < 2; i++ {
+		probeCached(t, c, "module=unauthorized&target="+target, cache)
+	}
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("SBC created %d times after an auth error, want 3", n)
+	}
+}
+
+func TestHandlerTLSVerifyFailed(t *testing.T) {
+	var calls int32
+	target := newTestSBCServer(t, &calls)
+
+	module := config.DefaultModule
+	module.Collectors = []string{"bad", "untrusted"}
+	c := &config.Config{Modules: map[string]config.Module{"default": module}}
+
+	body := probe(t, c, "target="+target)
+	if !strings.Contains(body, "probe_tls_verify_failed 1") {
+		t.Errorf("probe output does not report the TLS verification failure of a collector:\n%s", body)
+	}
+}
+
+func TestSelectProbersOptional(t *testing.T) {
+	probers, err := selectProbers(config.DefaultModule, nil)
+	if err != nil {
+		t.Fatalf("selectProbers() error = %v", err)
+	}
+	if _, ok := probers["good"]; !ok {
+		t.Errorf("selectProbers() without collectors does not run the default collector")
+	}
+	if _, ok := probers["optional"]; ok {
+		t.Errorf("selectProbers() without collectors runs an optional collector")
+	}
+	if _, err := selectProbers(config.DefaultModule, []string{"optional"}); err == nil {
+		t.Errorf("selectProbers() allowed collect[] of an optional collector the module does not list")
+	}
+
+	module := config.DefaultModule
+	module.Collectors = []string{"optional"}
+	if probers, err := selectProbers(module, nil); err != nil || len(probers) != 1 || probers["optional"] == nil {
+		t.Errorf("selectProbers() = %v, %v, want the optional collector listed by the module", probers, err)
+	}
+}
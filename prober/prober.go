@@ -14,13 +14,9 @@
 package prober
 
 import (
-	"context"
-
-	"github.com/go-kit/log"
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/ringsq/sonus_exporter/config"
 	"github.com/ringsq/sonus_exporter/sonus"
 )
 
-// A ProbeFn calls the SBC and adds its metrics to the registry
-type ProbeFn func(ctx context.Context, sbc *sonus.SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error
+// A ProbeFn calls the SBC using the module settings and adds its metrics to the registry.
+// Collectors register their ProbeFn with sonus.RegisterCollector.
+type ProbeFn = sonus.CollectorFn
@@ -0,0 +1,142 @@
+package sonus
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+/*
+<collection xmlns:y="http://tail-f.com/ns/rest">
+  <currentStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-ALARMS/1.0">
+    <alarmId>1049</alarmId>
+    <severity>major</severity>
+    <objectId>pkt0</objectId>
+    <desc>Link Down on port pkt0</desc>
+    <dateAndTime>2024-03-01T12:34:56+00:00</dateAndTime>
+  </currentStatus>
+</collection>
+*/
+
+type alarmCollection struct {
+	CurrentStatus []alarmStatus `xml:"currentStatus"`
+}
+
+type alarmStatus struct {
+	AlarmID     string `xml:"alarmId"`
+	Severity    string `xml:"severity"`
+	ObjectID    string `xml:"objectId"`
+	Desc        string `xml:"desc"`
+	DateAndTime string `xml:"dateAndTime"`
+}
+
+// alarmSeverities are the severities always reported by sonus_alarms, so a
+// severity without active alarms is 0 instead of absent
+var alarmSeverities = []string{"critical", "major", "minor", "info"}
+
+func init() {
+	RegisterOptionalCollector("alarms", AlarmMetrics)
+}
+
+// AlarmMetrics reports the active alarms of the SBC and their descriptions,
+// except the alarm IDs suppressed by the module.
+func AlarmMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+	var (
+		alarmLabels = []string{"system", "severity", "alarm_id", "object"}
+		active      = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sonus_alarm_active",
+			Help: "Active alarm of the SBC, always 1.",
+		}, alarmLabels)
+		raised = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sonus_alarm_raised_timestamp_seconds",
+			Help: "Time the active alarm was raised, in seconds since the epoch.",
+		}, alarmLabels)
+		count = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sonus_alarms",
+			Help: "Number of active alarms of the SBC, by severity.",
+		}, []string{"system", "severity"})
+		suppressed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sonus_alarms_suppressed",
+			Help: "Number of active alarms of the SBC that are suppressed by the configuration.",
+		}, []string{"system"})
+		info = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sonus_alarm_info",
+			Help: "Description of the active alarm of the SBC, always 1.",
+		}, []string{"system", "alarm_id", "object", "description"})
+		alarms = new(alarmCollection)
+	)
+	registry.MustRegister(active, raised, count, suppressed, info)
+
+	if err := sbc.GetAndParse(ctx, alarms, alarmStatusPath); err != nil {
+		return err
+	}
+
+	severities := map[string]float64{}
+	for _, severity := range alarmSeverities {
+		severities[severity] = 0
+	}
+	nSuppressed := 0.0
+	for _, alarm := range dedupeAlarms(alarms.CurrentStatus, logger) {
+		if module.Alarms.Suppressed(alarm.AlarmID) {
+			nSuppressed++
+			continue
+		}
+		severity := strings.ToLower(alarm.Severity)
+		severities[severity]++
+		labelValues := []string{sbc.System, severity, alarm.AlarmID, alarm.ObjectID}
+		active.WithLabelValues(labelValues...).Set(1)
+		info.WithLabelValues(sbc.System, alarm.AlarmID, alarm.ObjectID, alarm.Desc).Set(1)
+		if alarm.raised.IsZero() {
+			continue
+		}
+		raised.WithLabelValues(labelValues...).Set(float64(alarm.raised.Unix()))
+	}
+	for severity, n := range severities {
+		count.WithLabelValues(sbc.System, severity).Set(n)
+	}
+	suppressed.WithLabelValues(sbc.System).Set(nSuppressed)
+
+	return nil
+}
+
+// activeAlarm is an active alarm with its parsed raise time
+type activeAlarm struct {
+	alarmStatus
+	raised time.Time
+}
+
+// dedupeAlarms returns one alarm per alarm ID and object, in the order they
+// are listed, so that an alarm listed more than once for the same object is
+// counted once.  The earliest raise time of the duplicates is kept.
+func dedupeAlarms(statuses []alarmStatus, logger log.Logger) []*activeAlarm {
+	type key struct{ id, object string }
+	alarms := []*activeAlarm{}
+	seen := map[key]*activeAlarm{}
+	for _, status := range statuses {
+		var raised time.Time
+		if status.DateAndTime != "" {
+			t, err := time.Parse(time.RFC3339, status.DateAndTime)
+			if err != nil {
+				level.Warn(logger).Log("msg", "Failed to parse alarm raise time", "alarm_id", status.AlarmID, "time", status.DateAndTime, "err", err)
+			} else {
+				raised = t
+			}
+		}
+		k := key{status.AlarmID, status.ObjectID}
+		if alarm, ok := seen[k]; ok {
+			if !raised.IsZero() && (alarm.raised.IsZero() || raised.Before(alarm.raised)) {
+				alarm.raised = raised
+			}
+			continue
+		}
+		alarm := &activeAlarm{alarmStatus: status, raised: raised}
+		seen[k] = alarm
+		alarms = append(alarms, alarm)
+	}
+	return alarms
+}
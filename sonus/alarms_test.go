@@ -0,0 +1,82 @@
+package sonus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const testAlarmStatus = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <currentStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-ALARMS/1.0">
+    <alarmId>1049</alarmId>
+    <severity>MAJOR</severity>
+    <objectId>pkt0</objectId>
+    <desc>Link Down on port pkt0</desc>
+    <dateAndTime>2024-03-01T12:00:00+00:00</dateAndTime>
+  </currentStatus>
+  <currentStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-ALARMS/1.0">
+    <alarmId>1049</alarmId>
+    <severity>major</severity>
+    <objectId>pkt0</objectId>
+    <desc>Link Down on port pkt0</desc>
+    <dateAndTime>2024-03-01T11:00:00+00:00</dateAndTime>
+  </currentStatus>
+  <currentStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-ALARMS/1.0">
+    <alarmId>2120</alarmId>
+    <severity>minor</severity>
+    <objectId>TG1</objectId>
+    <desc>Trunk group congested</desc>
+  </currentStatus>
+  <currentStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-ALARMS/1.0">
+    <alarmId>9999</alarmId>
+    <severity>info</severity>
+    <objectId>system</objectId>
+  </currentStatus>
+</collection>`
+
+func TestAlarmMetrics(t *testing.T) {
+	sbc, module := newTestSBC(t, map[string]string{
+		alarmStatusPath: testAlarmStatus,
+	})
+	module.Alarms.SuppressIDs = []string{"9999"}
+	registry := prometheus.NewRegistry()
+	if err := AlarmMetrics(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("AlarmMetrics() error = %v", err)
+	}
+
+	// Severities are lower-cased and reported even without active alarms, and
+	// alarm 1049 is counted once although it is listed twice for pkt0
+	for severity, want := range map[string]float64{"critical": 0, "major": 1, "minor": 1, "info": 0} {
+		if v, ok := gatherValue(t, registry, "sonus_alarms", map[string]string{"severity": severity}); !ok || v != want {
+			t.Errorf("sonus_alarms{severity=%q} = %v (found %v), want %v", severity, v, ok, want)
+		}
+	}
+	if _, ok := gatherValue(t, registry, "sonus_alarm_active", map[string]string{"alarm_id": "9999"}); ok {
+		t.Errorf("AlarmMetrics() reported the suppressed alarm 9999")
+	}
+	if v, _ := gatherValue(t, registry, "sonus_alarms_suppressed", nil); v != 1 {
+		t.Errorf("sonus_alarms_suppressed = %v, want 1", v)
+	}
+	// The earliest raise time of the duplicates is kept
+	raised, _ := time.Parse(time.RFC3339, "2024-03-01T11:00:00+00:00")
+	if v, _ := gatherValue(t, registry, "sonus_alarm_raised_timestamp_seconds", map[string]string{"alarm_id": "1049"}); v != float64(raised.Unix()) {
+		t.Errorf("sonus_alarm_raised_timestamp_seconds of alarm 1049 = %v, want %v", v, raised.Unix())
+	}
+	info := map[string]string{"alarm_id": "2120", "object": "TG1", "description": "Trunk group congested"}
+	if v, ok := gatherValue(t, registry, "sonus_alarm_info", info); !ok || v != 1 {
+		t.Errorf("sonus_alarm_info of alarm 2120 = %v (found %v), want 1", v, ok)
+	}
+}
+
+func TestAlarmMetricsNotFound(t *testing.T) {
+	// An SBC without the alarm status fails the collector, as it only runs
+	// when a module lists it.
+	sbc, module := newTestSBC(t, nil)
+	err := AlarmMetrics(context.Background(), sbc, module, prometheus.NewRegistry(), log.NewNopLogger())
+	if got := ErrorType(err); got != ErrorTypeNotFound {
+		t.Errorf("AlarmMetrics() error = %v, want a %s error", err, ErrorTypeNotFound)
+	}
+}
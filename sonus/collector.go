@@ -0,0 +1,83 @@
+package sonus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+// A CollectorFn calls the SBC using the module settings and adds its metrics to the registry
+type CollectorFn func(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error
+
+type collector struct {
+	fn       CollectorFn
+	optional bool
+}
+
+var (
+	collectorsMu sync.RWMutex
+	collectors   = map[string]collector{}
+)
+
+// RegisterCollector makes a collector available under name.  The name is used
+// to select the collector in modules and probe requests, and as the value of
+// the collector label.  The collector runs by default, when a module does not
+// list its collectors.  It panics if the name is already registered.
+func RegisterCollector(name string, fn CollectorFn) {
+	registerCollector(name, collector{fn: fn})
+}
+
+// RegisterOptionalCollector makes a collector available under name, like
+// RegisterCollector, but the collector only runs when a module lists it.
+func RegisterOptionalCollector(name string, fn CollectorFn) {
+	registerCollector(name, collector{fn: fn, optional: true})
+}
+
+func registerCollector(name string, c collector) {
+	collectorsMu.Lock()
+	defer collectorsMu.Unlock()
+	if _, ok := collectors[name]; ok {
+		panic(fmt.Sprintf("collector %q registered twice", name))
+	}
+	collectors[name] = c
+}
+
+// LookupCollector returns the collector registered under name.
+func LookupCollector(name string) (CollectorFn, bool) {
+	collectorsMu.RLock()
+	defer collectorsMu.RUnlock()
+	c, ok := collectors[name]
+	return c.fn, ok
+}
+
+// CollectorNames returns the sorted names of the registered collectors.
+func CollectorNames() []string {
+	collectorsMu.RLock()
+	defer collectorsMu.RUnlock()
+	names := make([]string, 0, len(collectors))
+	for name := range collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultCollectorNames returns the sorted names of the collectors run when a
+// module does not list its collectors, i.e. all but the optional collectors.
+func DefaultCollectorNames() []string {
+	collectorsMu.RLock()
+	defer collectorsMu.RUnlock()
+	names := make([]string, 0, len(collectors))
+	for name, c := range collectors {
+		if !c.optional {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,26 @@
+package sonus
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCollectorNames(t *testing.T) {
+	want := []string{"alarms", "dsp", "fans", "ipinterfaces", "power", "system", "trafficcontrol", "trunkgroups", "zones"}
+	if got := CollectorNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CollectorNames() = %v, want %v", got, want)
+	}
+	want = []string{"dsp", "fans", "power", "system", "zones"}
+	if got := DefaultCollectorNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("DefaultCollectorNames() = %v, want %v", got, want)
+	}
+}
+
+func TestRegisterCollectorTwice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterCollector() did not panic for a duplicate name")
+		}
+	}()
+	RegisterCollector("fans", FanMetrics)
+}
@@ -0,0 +1,312 @@
+package sonus
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Decoder decodes the RESTCONF response bodies of one media type
+type Decoder interface {
+	// Accept returns the media type requested from the SBC
+	Accept() string
+	// Decode parses the response body into v
+	Decode(body []byte, v any) error
+	// Stream parses the response read from r and calls fn for every element
+	// with the local name.  The decode function parses the element into v.
+	Stream(r io.Reader, name string, fn func(decode func(v any) error) error) error
+}
+
+// decoders maps the module format setting to its Decoder
+var decoders = map[string]Decoder{
+	"xml":  xmlDecoder{},
+	"json": jsonDecoder{},
+}
+
+// NewDecoder returns the Decoder for the format: "xml" or "json".
+func NewDecoder(format string) (Decoder, error) {
+	if format == "" {
+		format = "xml"
+	}
+	dec, ok := decoders[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown RESTCONF format %q", format)
+	}
+	return dec, nil
+}
+
+type xmlDecoder struct{}
+
+func (xmlDecoder) Accept() string {
+	return "application/vnd.yang.collection+xml"
+}
+
+func (xmlDecoder) Decode(body []byte, v any) error {
+	return xml.Unmarshal(body, v)
+}
+
+// Stream decodes the elements one at a time, so only a single element of a
+// large response is held in memory.
+func (xmlDecoder) Stream(r io.Reader, name string, fn func(decode func(v any) error) error) error {
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != name {
+			continue
+		}
+		err = fn(func(v any) error {
+			return d.DecodeElement(v, &start)
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// jsonDecoder decodes RFC 7951 JSON into the structures used for the XML
+// responses.  The JSON members are matched to the struct fields by the local
+// name of their xml tag, ignoring the YANG module prefix of the member names,
+// and 64-bit numbers encoded as JSON strings are accepted for float64 fields.
+type jsonDecoder struct{}
+
+func (jsonDecoder) Accept() string {
+	return "application/yang-data+json"
+}
+
+func (jsonDecoder) Decode(body []byte, v any) error {
+	obj, err := parseJSON(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	rv, err := jsonTarget(v)
+	if err != nil {
+		return err
+	}
+	return decodeJSONStruct(rv, unwrapJSON(rv.Type(), obj))
+}
+
+// Stream parses the whole document, as the members of a JSON object are not
+// ordered, and calls fn for every entry of the list with the local name.
+func (jsonDecoder) Stream(r io.Reader, name string, fn func(decode func(v any) error) error) error {
+	obj, err := parseJSON(r)
+	if err != nil {
+		return err
+	}
+	val := findJSON(obj, name)
+	items, ok := val.([]any)
+	if !ok && val != nil {
+		items = []any{val}
+	}
+	for _, item := range items {
+		item := item
+		err = fn(func(v any) error {
+			rv, err := jsonTarget(v)
+			if err != nil {
+				return err
+			}
+			return decodeJSONValue(rv, item)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseJSON reads a JSON object, keeping the numbers as json.Number.
+func parseJSON(r io.Reader) (map[string]any, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot decode JSON %T, want object", doc)
+	}
+	return obj, nil
+}
+
+// jsonTarget returns the value v points to.
+func jsonTarget(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return reflect.Value{}, fmt.Errorf("cannot decode JSON into %T", v)
+	}
+	return rv.Elem(), nil
+}
+
+// findJSON returns the member with the local name, looking through the
+// containers wrapping the content of the response.
+func findJSON(obj map[string]any, name string) any {
+	for {
+		var inner map[string]any
+		for key, val := range obj {
+			if localName(key) == name {
+				return val
+			}
+			inner, _ = val.(map[string]any)
+		}
+		if len(obj) != 1 || inner == nil {
+			return nil
+		}
+		obj = inner
+	}
+}
+
+// unwrapJSON removes container members wrapping the content of the response,
+// such as "collection" or "ietf-restconf:errors", that have no matching field in t.
+func unwrapJSON(t reflect.Type, obj map[string]any) map[string]any {
+	for len(obj) == 1 {
+		var (
+			key string
+			val any
+		)
+		for key, val = range obj {
+		}
+		inner, ok := val.(map[string]any)
+		if !ok {
+			return obj
+		}
+		if _, ok := jsonFields(t)[localName(key)]; ok {
+			return obj
+		}
+		obj = inner
+	}
+	return obj
+}
+
+// jsonFields maps the local names of the xml tags of t to the field indexes.
+func jsonFields(t reflect.Type) map[string]int {
+	fields := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("xml")
+		if tag == "-" || f.Type == reflect.TypeOf(xml.Name{}) {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if strings.Contains(opts, "attr") || strings.Contains(opts, "chardata") {
+			continue
+		}
+		if i := strings.LastIndex(name, " "); i >= 0 {
+			name = name[i+1:]
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+// localName strips the YANG module prefix from a JSON member name
+func localName(key string) string {
+	if i := strings.LastIndex(key, ":"); i >= 0 {
+		return key[i+1:]
+	}
+	return key
+}
+
+func decodeJSONStruct(v reflect.Value, obj map[string]any) error {
+	fields := jsonFields(v.Type())
+	for key, val := range obj {
+		i, ok := fields[localName(key)]
+		if !ok {
+			continue
+		}
+		if err := decodeJSONValue(v.Field(i), val); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func decodeJSONValue(v reflect.Value, val any) error {
+	if val == nil {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeJSONValue(v.Elem(), val)
+	case reflect.Slice:
+		items, ok := val.([]any)
+		if !ok {
+			items = []any{val}
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeJSONValue(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	case reflect.Struct:
+		if items, ok := val.([]any); ok && len(items) > 0 {
+			val = items[0]
+		}
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", val, v.Type())
+		}
+		return decodeJSONStruct(v, obj)
+	case reflect.String:
+		switch val := val.(type) {
+		case string:
+			v.SetString(val)
+		case json.Number:
+			v.SetString(val.String())
+		case bool:
+			v.SetString(strconv.FormatBool(val))
+		case []any:
+			// YANG empty leafs are encoded as [null]
+		default:
+			return fmt.Errorf("cannot decode %T into %s", val, v.Type())
+		}
+	case reflect.Float64:
+		var s string
+		switch val := val.(type) {
+		case json.Number:
+			s = val.String()
+		case string:
+			s = val
+		default:
+			return fmt.Errorf("cannot decode %T into %s", val, v.Type())
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		switch val := val.(type) {
+		case bool:
+			v.SetBool(val)
+		case string:
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return err
+			}
+			v.SetBool(b)
+		default:
+			return fmt.Errorf("cannot decode %T into %s", val, v.Type())
+		}
+	}
+	return nil
+}
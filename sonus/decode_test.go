@@ -0,0 +1,156 @@
+package sonus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+func TestNewDecoder(t *testing.T) {
+	for format, accept := range map[string]string{
+		"":     "application/vnd.yang.collection+xml",
+		"xml":  "application/vnd.yang.collection+xml",
+		"json": "application/yang-data+json",
+	} {
+		dec, err := NewDecoder(format)
+		if err != nil {
+			t.Fatalf("NewDecoder(%q) error = %v", format, err)
+		}
+		if dec.Accept() != accept {
+			t.Errorf("NewDecoder(%q).Accept() = %q, want %q", format, dec.Accept(), accept)
+		}
+	}
+	if _, err := NewDecoder("yaml"); err == nil {
+		t.Error("NewDecoder(\"yaml\") error = nil, want error")
+	}
+}
+
+func TestJSONDecoder(t *testing.T) {
+	body := `{
+  "collection": {
+    "sonusSystem:fanStatus": [
+      {"serverName": "sbc1", "fanId": "FAN1", "speed": "5400 RPM"},
+      {"serverName": "sbc1", "fanId": "FAN2", "speed": "5520 RPM"}
+    ]
+  }
+}`
+	fans := &fanCollection{}
+	if err := (jsonDecoder{}).Decode([]byte(body), fans); err != nil {
+		t.Fatal(err)
+	}
+	if len(fans.FanStatus) != 2 || fans.FanStatus[1].FanID != "FAN2" || fans.FanStatus[1].Speed != "5520 RPM" {
+		t.Errorf("Decode() fans = %+v", fans.FanStatus)
+	}
+
+	// A single list entry and 64-bit counters encoded as strings
+	body = `{
+  "collection": {
+    "sonusDspStatus:dspUsage": {
+      "systemName": "sbc1",
+      "slot1ResourcesUtilized": 68,
+      "compressionAllocFailures": "18446744073709551615"
+    }
+  }
+}`
+	dsp := &dspUsageCollection{}
+	if err := (jsonDecoder{}).Decode([]byte(body), dsp); err != nil {
+		t.Fatal(err)
+	}
+	if dsp.DSPUsage == nil || dsp.DSPUsage.SystemName != "sbc1" || dsp.DSPUsage.Slot1ResourcesUtilized != 68 {
+		t.Fatalf("Decode() dsp = %+v", dsp.DSPUsage)
+	}
+	if dsp.DSPUsage.CompressionAllocFailures != 18446744073709551615 {
+		t.Errorf("Decode() compressionAllocFailures = %v", dsp.DSPUsage.CompressionAllocFailures)
+	}
+
+	body = `{
+  "ietf-restconf:errors": {
+    "error": [
+      {"error-tag": "access-denied", "error-message": "access denied"}
+    ]
+  }
+}`
+	sbcErr := &Errors{}
+	if err := (jsonDecoder{}).Decode([]byte(body), sbcErr); err != nil {
+		t.Fatal(err)
+	}
+	if len(sbcErr.ErrorMsgs) != 1 || sbcErr.ErrorMsgs[0].ErrorTag != "access-denied" {
+		t.Errorf("Decode() errors = %+v", sbcErr.ErrorMsgs)
+	}
+
+	if err := (jsonDecoder{}).Decode([]byte(`{"collection": {"sonusDspStatus:dspUsage": {"slot1ResourcesUtilized": "n/a"}}}`), dsp); err == nil {
+		t.Error("Decode() with invalid number error = nil, want error")
+	}
+}
+
+func TestGetAndParseJSON(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "application/yang-data+json" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/admin"):
+			w.Write([]byte(`{"collection": {"sonusSystem:admin": [{"name": "testsbc"}]}}`))
+		case strings.Contains(r.URL.Path, "/fanStatus"):
+			w.Write([]byte(`{"collection": {"sonusSystem:fanStatus": [{"fanId": `))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"ietf-restconf:errors": {"error": [{"error-tag": "access-denied", "error-message": "access denied"}]}}`))
+		}
+	}))
+	defer srv.Close()
+
+	module := config.DefaultModule
+	module.Format = "json"
+	module.AddressContexts.Discovery = false
+	sbc, err := NewSBC(context.Background(), strings.TrimPrefix(srv.URL, "https://"), "user", "password", module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sbc.System != "testsbc" {
+		t.Errorf("NewSBC() system = %q, want %q", sbc.System, "testsbc")
+	}
+
+	err = sbc.GetAndParse(context.Background(), &fanCollection{}, fanStatusPath)
+	if got := ErrorType(err); got != ErrorTypeParse {
+		t.Errorf("ErrorType() of truncated JSON = %q, want %q", got, ErrorTypeParse)
+	}
+
+	err = sbc.GetAndParse(context.Background(), &ServerInfo{}, serverInfoPath)
+	var restErr *RestconfError
+	if !errors.As(err, &restErr) {
+		t.Fatalf("GetAndParse() error %T is not a *RestconfError", err)
+	}
+	if restErr.ErrorTag != "access-denied" || ErrorType(err) != ErrorTypeAccessDenied {
+		t.Errorf("GetAndParse() error tag = %q, type = %q", restErr.ErrorTag, ErrorType(err))
+	}
+}
+
+func TestJSONDecoderStream(t *testing.T) {
+	body := `{"collection": {"sonusZone:zone": [{"name": "ZONE_A", "sipSigPortStatistics": {"callRate": 3}}, {"name": "ZONE_B"}]}}`
+	var names []string
+	err := (jsonDecoder{}).Stream(strings.NewReader(body), "zone", func(decode func(v any) error) error {
+		zone := &Zone{}
+		if err := decode(zone); err != nil {
+			return err
+		}
+		names = append(names, zone.Name)
+		if zone.Name == "ZONE_A" && zone.SipSigPortStatistics.CallRate != 3 {
+			t.Errorf("Stream() callRate = %v, want 3", zone.SipSigPortStatistics.CallRate)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(names, ",") != "ZONE_A,ZONE_B" {
+		t.Errorf("Stream() zones = %v, want [ZONE_A ZONE_B]", names)
+	}
+}
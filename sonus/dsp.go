@@ -99,7 +99,11 @@ type dspUsage struct {
 	Silk16Utilization                  float64 `xml:"silk16Utilization"`
 }
 
-func DSPMetrics(ctx context.Context, sbc *SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error {
+func init() {
+	RegisterCollector("dsp", DSPMetrics)
+}
+
+func DSPMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
 	var (
 		DSP_Resources_Used = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "sonus_dsp_resources_used",
@@ -1,8 +1,13 @@
 package sonus
 
 import (
+	"context"
+	"crypto/x509"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"strings"
 )
 
@@ -29,3 +34,150 @@ func (e *Errors) Error() string {
 	}
 	return strings.Join(errors, "\n")
 }
+
+// IsTLSVerifyError reports whether err was caused by the SBC certificate
+// failing verification, as opposed to the SBC being unreachable.
+func IsTLSVerifyError(err error) bool {
+	var (
+		unknownAuthority x509.UnknownAuthorityError
+		hostname         x509.HostnameError
+		invalid          x509.CertificateInvalidError
+	)
+	return errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalid)
+}
+
+// IsSessionError reports whether err means the connection to the SBC or its
+// credentials are no longer usable, so that a cached SBC must be created again.
+func IsSessionError(err error) bool {
+	switch ErrorType(err) {
+	case ErrorTypeAuth, ErrorTypeTLS, ErrorTypeConnection:
+		return true
+	}
+	return false
+}
+
+// ErrBodyTooLarge is returned when a RESTCONF response exceeds the max_body_size of the module.
+var ErrBodyTooLarge = errors.New("response body exceeds max_body_size")
+
+// DecodeError is returned when a RESTCONF response could not be parsed.
+type DecodeError struct {
+	// URLPath is the path of the requested URL
+	URLPath string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("error parsing response of %s: %v", e.URLPath, e.Err)
+}
+
+// Unwrap returns the error of the decoder.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// The error types reported by ErrorType
+const (
+	ErrorTypeAuth         = "auth"
+	ErrorTypeAccessDenied = "access_denied"
+	ErrorTypeNotFound     = "not_found"
+	ErrorTypeOverloaded   = "overloaded"
+	ErrorTypeServer       = "server"
+	ErrorTypeClient       = "client"
+	ErrorTypeStatus       = "unexpected_status"
+	ErrorTypeTLS          = "tls"
+	ErrorTypeTimeout      = "timeout"
+	ErrorTypeConnection   = "connection"
+	ErrorTypeParse        = "parse"
+	ErrorTypeBodySize     = "body_size_limit"
+	ErrorTypeUnknown      = "unknown"
+)
+
+// RestconfError is returned when the SBC answers a RESTCONF request with an
+// unexpected status.  Errors holds the <errors> document of the response, if any.
+type RestconfError struct {
+	StatusCode int
+	Status     string
+	// URLPath is the path of the requested URL
+	URLPath string
+	// ErrorTag is the error-tag of the first error in the response
+	ErrorTag string
+	Errors   *Errors
+}
+
+func newRestconfError(resp *http.Response, sbcErr *Errors) *RestconfError {
+	e := &RestconfError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Errors:     sbcErr,
+	}
+	if resp.Request != nil {
+		e.URLPath = resp.Request.URL.Path
+	}
+	if sbcErr != nil && len(sbcErr.ErrorMsgs) > 0 {
+		e.ErrorTag = sbcErr.ErrorMsgs[0].ErrorTag
+	}
+	return e
+}
+
+// Error implements the error interface.
+func (e *RestconfError) Error() string {
+	if e.Errors != nil && len(e.Errors.ErrorMsgs) > 0 {
+		return fmt.Sprintf("%s returned %s: %s", e.URLPath, e.Status, e.Errors.Error())
+	}
+	return fmt.Sprintf("%s returned %s", e.URLPath, e.Status)
+}
+
+// Unwrap returns the errors reported by the SBC.
+func (e *RestconfError) Unwrap() error {
+	if e.Errors == nil {
+		return nil
+	}
+	return e.Errors
+}
+
+// Type classifies the error based on the status code and error-tag.
+func (e *RestconfError) Type() string {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrorTypeAuth
+	case e.StatusCode == http.StatusForbidden || e.ErrorTag == "access-denied":
+		return ErrorTypeAccessDenied
+	case e.StatusCode == http.StatusNotFound || e.ErrorTag == "data-missing":
+		return ErrorTypeNotFound
+	case e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusServiceUnavailable || e.ErrorTag == "resource-denied":
+		return ErrorTypeOverloaded
+	case e.StatusCode >= 500:
+		return ErrorTypeServer
+	case e.StatusCode >= 400:
+		return ErrorTypeClient
+	}
+	return ErrorTypeStatus
+}
+
+// ErrorType classifies an error returned while probing an SBC, so that bad
+// credentials can be told apart from an unreachable or overloaded SBC.
+func ErrorType(err error) string {
+	var (
+		restconfErr *RestconfError
+		netErr      net.Error
+		decodeErr   *DecodeError
+	)
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &restconfErr):
+		return restconfErr.Type()
+	case errors.Is(err, ErrBodyTooLarge):
+		return ErrorTypeBodySize
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+		return ErrorTypeTimeout
+	case IsTLSVerifyError(err):
+		return ErrorTypeTLS
+	case errors.As(err, &netErr):
+		return ErrorTypeConnection
+	case errors.As(err, &decodeErr):
+		return ErrorTypeParse
+	}
+	return ErrorTypeUnknown
+}
@@ -0,0 +1,132 @@
+package sonus
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+const testSystemAdmin = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <admin xmlns="http://sonusnet.com/ns/mibs/SONUS-SYSTEM-MIB/1.0">
+    <name>testsbc</name>
+  </admin>
+</collection>`
+
+func TestIsTLSVerifyError(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testSystemAdmin))
+	}))
+	defer srv.Close()
+	target := strings.TrimPrefix(srv.URL, "https://")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	module := config.DefaultModule
+	module.TLSConfig.InsecureSkipVerify = false
+	_, err := NewSBC(context.Background(), target, "user", "password", module)
+	if !IsTLSVerifyError(err) {
+		t.Errorf("NewSBC() with untrusted certificate error = %v, want TLS verification error", err)
+	}
+
+	module.TLSConfig.CAFile = caFile
+	module.TLSConfig.ServerName = "example.com"
+	sbc, err := NewSBC(context.Background(), target, "user", "password", module)
+	if err != nil {
+		t.Fatalf("NewSBC() with trusted certificate error = %v", err)
+	}
+	if sbc.System != "testsbc" {
+		t.Errorf("NewSBC() system = %q, want %q", sbc.System, "testsbc")
+	}
+
+	module.TLSConfig.ServerName = "sbc.example.org"
+	_, err = NewSBC(context.Background(), target, "user", "password", module)
+	if !IsTLSVerifyError(err) {
+		t.Errorf("NewSBC() with mismatched server name error = %v, want TLS verification error", err)
+	}
+}
+
+const testAccessDenied = `<errors xmlns="urn:ietf:params:xml:ns:yang:ietf-restconf">
+  <error>
+    <error-tag>access-denied</error-tag>
+    <error-urlpath>/restconf/data/sonusAddressContext:addressContext</error-urlpath>
+    <error-message>access denied</error-message>
+  </error>
+</errors>`
+
+func TestErrorType(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/admin"):
+			w.Write([]byte(testSystemAdmin))
+		case strings.HasSuffix(r.URL.Path, contextListPath):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(testAccessDenied))
+		case strings.HasSuffix(r.URL.Path, fanStatusPath):
+			w.WriteHeader(http.StatusUnauthorized)
+		case strings.HasSuffix(r.URL.Path, powerSupplyPath):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case strings.HasSuffix(r.URL.Path, serverInfoPath):
+			w.Write([]byte("<collection><serverStatus>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	target := strings.TrimPrefix(srv.URL, "https://")
+
+	module := config.DefaultModule
+	module.AddressContexts = config.AddressContexts{Static: []string{"default"}}
+	sbc, err := NewSBC(context.Background(), target, "user", "password", module)
+	if err != nil {
+		t.Fatalf("NewSBC() error = %v", err)
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: contextListPath, want: ErrorTypeAccessDenied},
+		{path: fanStatusPath, want: ErrorTypeAuth},
+		{path: powerSupplyPath, want: ErrorTypeOverloaded},
+		{path: dspStatusPath, want: ErrorTypeNotFound},
+		{path: serverInfoPath, want: ErrorTypeParse},
+	}
+	for _, tt := range tests {
+		err := sbc.GetAndParse(context.Background(), &struct{}{}, tt.path)
+		if got := ErrorType(err); got != tt.want {
+			t.Errorf("ErrorType(%s) = %q (%v), want %q", tt.path, got, err, tt.want)
+		}
+	}
+
+	err = sbc.GetAndParse(context.Background(), &struct{}{}, contextListPath)
+	var restconfErr *RestconfError
+	if !errors.As(err, &restconfErr) {
+		t.Fatalf("GetAndParse() error %T is not a *RestconfError", err)
+	}
+	if restconfErr.StatusCode != http.StatusBadRequest || restconfErr.ErrorTag != "access-denied" ||
+		!strings.HasSuffix(restconfErr.URLPath, contextListPath) {
+		t.Errorf("unexpected RestconfError: %+v", restconfErr)
+	}
+	var sbcErrs *Errors
+	if !errors.As(err, &sbcErrs) || sbcErrs.ErrorMsgs[0].ErrorMessage != "access denied" {
+		t.Errorf("RestconfError does not wrap the SBC errors: %v", err)
+	}
+
+	srv.Close()
+	_, err = NewSBC(context.Background(), target, "user", "password", module)
+	if got := ErrorType(err); got != ErrorTypeConnection {
+		t.Errorf("ErrorType() for a stopped SBC = %q (%v), want %q", got, err, ErrorTypeConnection)
+	}
+}
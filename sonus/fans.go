@@ -38,7 +38,11 @@ func (f fanStatus) speedToRPM() (float64, error) {
 	return strconv.ParseFloat(rpm, 64)
 }
 
-func FanMetrics(ctx context.Context, sbc *SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error {
+func init() {
+	RegisterCollector("fans", FanMetrics)
+}
+
+func FanMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
 	var (
 		Fan_Speed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "sonus_fan_speed",
@@ -0,0 +1,79 @@
+package sonus
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Exporter metrics about the calls to the SBCs, exposed on /metrics
+var (
+	sbcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_requests_total",
+		Help:      "Number of RESTCONF requests sent to the SBCs, by target, endpoint and response status class.",
+	}, []string{"target", "endpoint", "status_class"})
+
+	sbcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_request_duration_seconds",
+		Help:      "Time until the response headers of a RESTCONF request were received, by target and endpoint.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"target", "endpoint"})
+
+	sbcResponseSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_response_size_bytes",
+		Help:      "Size of the RESTCONF response bodies read from the SBCs, by target and endpoint.",
+		Buckets:   prometheus.ExponentialBuckets(1024, 4, 8),
+	}, []string{"target", "endpoint"})
+
+	sbcRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sonus_exporter",
+		Name:      "sbc_request_retries_total",
+		Help:      "Number of SBC requests retried because of a retryable response status, by target and endpoint.",
+	}, []string{"target", "endpoint"})
+)
+
+func init() {
+	prometheus.MustRegister(sbcRequests)
+	prometheus.MustRegister(sbcRequestDuration)
+	prometheus.MustRegister(sbcResponseSize)
+	prometheus.MustRegister(sbcRetries)
+}
+
+// endpointNames maps the RESTCONF path templates to the endpoint label values
+var endpointNames = map[string]string{
+	systemInfoPath:       "systemInfo",
+	serverInfoPath:       "serverInfo",
+	contextListPath:      "contextList",
+	zoneStatusPath:       "zoneStatus",
+	ipInterfaceGroupPath: "ipInterfaceGroup",
+	sipStatsPath:         "sipStats",
+	fanStatusPath:        "fanStatus",
+	powerSupplyPath:      "powerSupply",
+	dspStatusPath:        "dspStatus",
+	tgStatusPath:         "tgStatus",
+	tgConfigPath:         "tgConfig",
+	callStatusPath:       "callStatus",
+	trafficControlPath:   "trafficControl",
+	alarmStatusPath:      "alarmStatus",
+	congestionStatusPath: "congestionStatus",
+}
+
+// endpointName returns the endpoint label value for a path template.
+func endpointName(path string) string {
+	if name, ok := endpointNames[path]; ok {
+		return name
+	}
+	return path
+}
+
+// statusClass returns the status class label value for a response status code.
+// 204 is reported on its own, as the SBC uses it when the data is not ready yet.
+func statusClass(statusCode int) string {
+	if statusCode == 204 {
+		return "204"
+	}
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
@@ -0,0 +1,52 @@
+package sonus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+func TestStatusClass(t *testing.T) {
+	for code, want := range map[int]string{200: "2xx", 204: "204", 401: "4xx", 404: "4xx", 503: "5xx"} {
+		if got := statusClass(code); got != want {
+			t.Errorf("statusClass(%d) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestRequestInstrumentation(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/fanStatus/") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testSystemAdmin))
+	}))
+	defer srv.Close()
+	target := strings.TrimPrefix(srv.URL, "https://")
+
+	module := config.DefaultModule
+	module.AddressContexts = config.AddressContexts{Static: []string{"default"}}
+	sbc, err := NewSBC(context.Background(), target, "user", "password", module)
+	if err != nil {
+		t.Fatalf("NewSBC() error = %v", err)
+	}
+	if err := sbc.GetAndParse(context.Background(), &fanCollection{}, fanStatusPath); err == nil {
+		t.Fatalf("GetAndParse() succeeded for a 503 response")
+	}
+
+	if got := testutil.ToFloat64(sbcRequests.WithLabelValues(target, "systemInfo", "2xx")); got != 1 {
+		t.Errorf("systemInfo 2xx requests = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(sbcRequests.WithLabelValues(target, "fanStatus", "5xx")); got != 1 {
+		t.Errorf("fanStatus 5xx requests = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(sbcResponseSize); got == 0 {
+		t.Errorf("no response sizes recorded")
+	}
+}
@@ -0,0 +1,125 @@
+package sonus
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+	"golang.org/x/sync/errgroup"
+)
+
+/*
+<collection xmlns:y="http://tail-f.com/ns/rest">
+  <ipInterfaceGroup>
+    <name>LIF1</name>
+    <ipInterfaceStatus>
+      <name>LIF1_PKT0</name>
+      <ifIndex>3</ifIndex>
+      <operState>resAllocated</operState>
+      <adminState>enabled</adminState>
+      <portName>pkt0</portName>
+      <vlanTag>100</vlanTag>
+      <ipAddress>10.1.1.10</ipAddress>
+      <allocatedBw>1200</allocatedBw>
+      ...
+    </ipInterfaceStatus>
+  </ipInterfaceGroup>
+</collection>
+*/
+
+type ipInterfaceGroupCollection struct {
+	IpInterfaceGroup []ipInterfaceGroup `xml:"ipInterfaceGroup"`
+}
+
+type ipInterfaceGroup struct {
+	Name              string              `xml:"name"`
+	IpInterfaceStatus []IpInterfaceStatus `xml:"ipInterfaceStatus"`
+}
+
+// IpInterfaceStatus is the status of an IP interface of an IP interface group
+type IpInterfaceStatus struct {
+	Name              string  `xml:"name"`
+	IfIndex           string  `xml:"ifIndex"`
+	OperState         string  `xml:"operState"`
+	AdminState        string  `xml:"adminState"`
+	PortName          string  `xml:"portName"`
+	VlanTag           string  `xml:"vlanTag"`
+	IpAddress         string  `xml:"ipAddress"`
+	AltIpAddress      string  `xml:"altIpAddress"`
+	AllocatedBw       float64 `xml:"allocatedBw"`
+	ActualBwAvailable float64 `xml:"actualBwAvailable"`
+	TxPackets         float64 `xml:"txPackets"`
+	RxPackets         float64 `xml:"rxPackets"`
+	TxBytes           float64 `xml:"txBytes"`
+	RxBytes           float64 `xml:"rxBytes"`
+	TxErrors          float64 `xml:"txErrors"`
+	RxErrors          float64 `xml:"rxErrors"`
+}
+
+// ipInterfaceOperStates are the operational states of an IP interface.  The
+// interface only carries traffic once its resources are allocated.
+var ipInterfaceOperStates = []string{"resAllocated", "resAllocFailed", "resNotAllocated"}
+
+// ipInterfaceAdminStates are the administrative states of an IP interface
+var ipInterfaceAdminStates = []string{"enabled", "disabled"}
+
+// ipInterfaceLabels are the labels of the IP interface metrics
+var ipInterfaceLabels = []string{"system", "addresscontext", "group", "interface"}
+
+func init() {
+	RegisterOptionalCollector("ipinterfaces", IPInterfaceMetrics)
+}
+
+// IPInterfaceMetrics reports the state, bandwidth and traffic of the IP
+// interfaces of every address context.
+func IPInterfaceMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+	metrics := newFieldMetrics(registry, "sonus_ip_interface", reflect.TypeOf(IpInterfaceStatus{}), ipInterfaceLabels)
+	stateLabels := append(ipInterfaceLabels[:len(ipInterfaceLabels):len(ipInterfaceLabels)], "state")
+	operState := NewMetricVec("sonus_ip_interface_oper_state", "Operational state of the IP interface, 1 for the current state.",
+		prometheus.GaugeValue, stateLabels)
+	adminState := NewMetricVec("sonus_ip_interface_admin_state", "Administrative state of the IP interface, 1 for the current state.",
+		prometheus.GaugeValue, stateLabels)
+	up := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sonus_ip_interface_up",
+		Help: "1 when the IP interface is enabled and its resources are allocated.",
+	}, ipInterfaceLabels)
+	info := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sonus_ip_interface_info",
+		Help: "Port, VLAN and addresses of the IP interface, always 1.",
+	}, append(ipInterfaceLabels[:len(ipInterfaceLabels):len(ipInterfaceLabels)], "port", "vlan", "ip_address", "alt_ip_address"))
+	registry.MustRegister(operState, adminState, up, info)
+
+	g := &errgroup.Group{}
+	for _, aCtx := range sbc.AddressContexts.AddressContext {
+		aCtx := aCtx
+		g.Go(func() error {
+			groups := &ipInterfaceGroupCollection{}
+			if err := sbc.GetAndParse(ctx, groups, ipInterfaceGroupPath, aCtx.Name); err != nil {
+				return err
+			}
+			for _, group := range groups.IpInterfaceGroup {
+				for _, s := range group.IpInterfaceStatus {
+					labelValues := []string{sbc.System, aCtx.Name, group.Name, s.Name}
+					metrics.set(reflect.ValueOf(s), labelValues...)
+					if !setStates(operState, ipInterfaceOperStates, s.OperState, labelValues...) {
+						level.Warn(logger).Log("msg", "Unknown IP interface operational state", "interface", s.Name, "state", s.OperState)
+					}
+					if !setStates(adminState, ipInterfaceAdminStates, s.AdminState, labelValues...) {
+						level.Warn(logger).Log("msg", "Unknown IP interface administrative state", "interface", s.Name, "state", s.AdminState)
+					}
+					isUp := 0.0
+					if s.OperState == "resAllocated" && s.AdminState != "disabled" {
+						isUp = 1
+					}
+					up.WithLabelValues(labelValues...).Set(isUp)
+					info.WithLabelValues(append(labelValues, s.PortName, s.VlanTag, s.IpAddress, s.AltIpAddress)...).Set(1)
+				}
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,66 @@
+package sonus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const testIPInterfaceGroup = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <ipInterfaceGroup>
+    <name>LIF1</name>
+    <ipInterfaceStatus>
+      <name>LIF1_PKT0</name>
+      <operState>resAllocated</operState>
+      <adminState>enabled</adminState>
+      <portName>pkt0</portName>
+      <vlanTag>100</vlanTag>
+      <ipAddress>10.1.1.10</ipAddress>
+      <allocatedBw>1200</allocatedBw>
+      <rxPackets>5000</rxPackets>
+    </ipInterfaceStatus>
+    <ipInterfaceStatus>
+      <name>LIF1_PKT1</name>
+      <operState>resAllocFailed</operState>
+      <adminState>enabled</adminState>
+      <portName>pkt1</portName>
+      <vlanTag>200</vlanTag>
+      <ipAddress>10.2.1.10</ipAddress>
+    </ipInterfaceStatus>
+  </ipInterfaceGroup>
+</collection>`
+
+func TestIPInterfaceMetrics(t *testing.T) {
+	// The test SBC only answers the RESTCONF IP interface group path of the
+	// default address context
+	sbc, module := newTestSBC(t, map[string]string{
+		fmt.Sprintf(ipInterfaceGroupPath, "default"): testIPInterfaceGroup,
+	})
+	registry := prometheus.NewRegistry()
+	if err := IPInterfaceMetrics(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("IPInterfaceMetrics() error = %v", err)
+	}
+
+	for iface, want := range map[string]float64{"LIF1_PKT0": 1, "LIF1_PKT1": 0} {
+		labels := map[string]string{"addresscontext": "default", "group": "LIF1", "interface": iface}
+		if v, ok := gatherValue(t, registry, "sonus_ip_interface_up", labels); !ok || v != want {
+			t.Errorf("sonus_ip_interface_up of %s = %v (found %v), want %v", iface, v, ok, want)
+		}
+	}
+	if v, _ := gatherValue(t, registry, "sonus_ip_interface_rx_packets_total", map[string]string{"interface": "LIF1_PKT0"}); v != 5000 {
+		t.Errorf("sonus_ip_interface_rx_packets_total of LIF1_PKT0 = %v, want 5000", v)
+	}
+}
+
+func TestIPInterfaceMetricsNotFound(t *testing.T) {
+	// An SBC without the IP interface groups fails the collector, as it only
+	// runs when a module lists it.
+	sbc, module := newTestSBC(t, nil)
+	err := IPInterfaceMetrics(context.Background(), sbc, module, prometheus.NewRegistry(), log.NewNopLogger())
+	if got := ErrorType(err); got != ErrorTypeNotFound {
+		t.Errorf("IPInterfaceMetrics() error = %v, want a %s error", err, ErrorTypeNotFound)
+	}
+}
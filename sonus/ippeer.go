@@ -0,0 +1,53 @@
+package sonus
+
+import (
+	"reflect"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ipPeerLabels are the labels of the IP peer metrics
+var ipPeerLabels = []string{"system", "addresscontext", "zone", "peer"}
+
+// ipPeerMetrics are the sonus_ip_peer_* metrics of the IP peers of the zones
+type ipPeerMetrics struct {
+	qos      fieldMetrics
+	current  fieldMetrics
+	interval fieldMetrics
+	info     *prometheus.GaugeVec
+}
+
+func newIPPeerMetrics(registry *prometheus.Registry) *ipPeerMetrics {
+	zone := reflect.TypeOf(Zone{})
+	field := func(name string) reflect.Type {
+		f, _ := zone.FieldByName(name)
+		return f.Type.Elem()
+	}
+	m := &ipPeerMetrics{
+		qos:      newFieldMetrics(registry, "sonus_ip_peer", field("PeerQosStatus"), ipPeerLabels),
+		current:  newFieldMetrics(registry, "sonus_ip_peer", field("IpPeerCurrentStatistics"), ipPeerLabels),
+		interval: newFieldMetrics(registry, "sonus_ip_peer_interval", field("IpPeerIntervalStatistics"), ipPeerLabels),
+		info: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: prometheus.BuildFQName("sonus", "ip_peer", "info"),
+			Help: "Address of the IP peer, always 1.",
+		}, append(ipPeerLabels[:len(ipPeerLabels):len(ipPeerLabels)], "ip_address", "ip_port", "fqdn", "fqdn_port")),
+	}
+	registry.MustRegister(m.info)
+	return m
+}
+
+// process sets the metrics of the IP peers of the zone
+func (m *ipPeerMetrics) process(system, context string, zone *Zone) {
+	for _, p := range zone.IpPeer {
+		m.info.WithLabelValues(system, context, zone.Name, p.Name, p.IpAddress, p.IpPort, p.Policy.Sip.Fqdn, p.Policy.Sip.FqdnPort).Set(1)
+	}
+	for _, s := range zone.PeerQosStatus {
+		m.qos.set(reflect.ValueOf(s), system, context, zone.Name, s.Name)
+	}
+	for _, s := range zone.IpPeerCurrentStatistics {
+		m.current.set(reflect.ValueOf(s), system, context, zone.Name, s.Name)
+	}
+	for _, s := range zone.IpPeerIntervalStatistics {
+		m.interval.set(reflect.ValueOf(s), system, context, zone.Name, s.Name)
+	}
+}
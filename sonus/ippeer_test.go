@@ -0,0 +1,77 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestIPPeerMetrics(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <ipPeer>
+    <name>PEER1</name>
+    <ipAddress>192.0.2.10</ipAddress>
+    <ipPort>5060</ipPort>
+    <policy>
+      <sip>
+        <fqdn>sip.example.com</fqdn>
+        <fqdnPort>5061</fqdnPort>
+      </sip>
+    </policy>
+  </ipPeer>
+  <peerQosStatus>
+    <name>PEER1</name>
+    <currentASR>55</currentASR>
+    <qosDropCount>3</qosDropCount>
+  </peerQosStatus>
+  <ipPeerCurrentStatistics>
+    <name>PEER1</name>
+    <inboundCPS>4</inboundCPS>
+  </ipPeerCurrentStatistics>
+  <ipPeerIntervalStatistics>
+    <number>1</number>
+    <name>PEER1</name>
+    <intervalValid>true</intervalValid>
+    <inboundCPS>2</inboundCPS>
+  </ipPeerIntervalStatistics>
+  <ipPeerIntervalStatistics>
+    <number>2</number>
+    <name>PEER1</name>
+    <intervalValid>false</intervalValid>
+    <inboundCPS>9</inboundCPS>
+  </ipPeerIntervalStatistics>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newIPPeerMetrics(registry).process("sbc1", "default", zone)
+
+	expected := `
+# HELP sonus_ip_peer_current_asr CurrentASR
+# TYPE sonus_ip_peer_current_asr gauge
+sonus_ip_peer_current_asr{addresscontext="default",peer="PEER1",system="sbc1",zone="ZONE_A"} 55
+# HELP sonus_ip_peer_inbound_cps InboundCPS
+# TYPE sonus_ip_peer_inbound_cps gauge
+sonus_ip_peer_inbound_cps{addresscontext="default",peer="PEER1",system="sbc1",zone="ZONE_A"} 4
+# HELP sonus_ip_peer_info Address of the IP peer, always 1.
+# TYPE sonus_ip_peer_info gauge
+sonus_ip_peer_info{addresscontext="default",fqdn="sip.example.com",fqdn_port="5061",ip_address="192.0.2.10",ip_port="5060",peer="PEER1",system="sbc1",zone="ZONE_A"} 1
+# HELP sonus_ip_peer_interval_inbound_cps InboundCPS
+# TYPE sonus_ip_peer_interval_inbound_cps gauge
+sonus_ip_peer_interval_inbound_cps{addresscontext="default",interval="1",peer="PEER1",system="sbc1",zone="ZONE_A"} 2
+# HELP sonus_ip_peer_qos_drop_count_total QosDropCount
+# TYPE sonus_ip_peer_qos_drop_count_total counter
+sonus_ip_peer_qos_drop_count_total{addresscontext="default",peer="PEER1",system="sbc1",zone="ZONE_A"} 3
+`
+	err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_ip_peer_current_asr", "sonus_ip_peer_inbound_cps", "sonus_ip_peer_info",
+		"sonus_ip_peer_interval_inbound_cps", "sonus_ip_peer_qos_drop_count_total")
+	if err != nil {
+		t.Error(err)
+	}
+}
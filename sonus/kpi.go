@@ -0,0 +1,159 @@
+package sonus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// callStats are the current call and call failure statistics of a trunk
+// group.  failure is nil when the SBC returned no failure statistics for it.
+type callStats struct {
+	*CallCurrentStatistics
+	failure *CallFailureCurrentStatistics
+}
+
+// inNetworkFailures are the inbound call failures caused by the network rather
+// than by the called party
+func (s callStats) inNetworkFailures() float64 {
+	f := s.failure
+	return f.InCallFailNoRoutes + f.InCallFailNoResources + f.InCallFailNoService + f.InCallFailNetworkFailure + f.InCallFailProtocolError
+}
+
+// outNetworkFailures are the outbound call failures caused by the network
+// rather than by the called party
+func (s callStats) outNetworkFailures() float64 {
+	f := s.failure
+	return f.OutCallFailNoRoutes + f.OutCallFailNoResources + f.OutCallFailNoService + f.OutCallFailNetworkFailure + f.OutCallFailProtocolError
+}
+
+func (s callStats) inFailures() float64 {
+	return s.inNetworkFailures() + s.failure.InCallFailInvalidCall + s.failure.InCallFailUnspecified
+}
+
+func (s callStats) outFailures() float64 {
+	return s.outNetworkFailures() + s.failure.OutCallFailInvalidCall + s.failure.OutCallFailUnspecified
+}
+
+// ratio returns the numerator and denominator of a derived KPI.  A zero
+// denominator leaves the KPI out for the trunk group.
+type ratio func(s callStats) (numerator, denominator float64)
+
+// derivedKPI is a KPI computed from the call statistics of a trunk group.
+// Values maps the direction label to the ratio of the direction, the "" key
+// is a KPI without a direction label.
+type derivedKPI struct {
+	name   string
+	help   string
+	values map[string]ratio
+}
+
+// derivedKPIs are the sonus_trunkgroup_* KPIs published with derived_kpis.
+// They are computed from the cumulative counters of the current statistics,
+// so they cover the time since the counters were last reset; use the rates of
+// the underlying _total counters for windowed values.
+//
+//	asr_ratio                   calls / call attempts
+//	ner_ratio                   (call attempts - network failures) / call attempts,
+//	                            network failures being the no route, no resources,
+//	                            no service, network failure and protocol error failures
+//	call_failure_ratio          all call failures / call attempts
+//	average_call_setup_seconds  callSetupTime (1/100 s) / 100 / callSetups
+var derivedKPIs = []derivedKPI{
+	{
+		name: "asr_ratio",
+		help: "Answer seizure ratio of the trunk group: completed calls divided by call attempts.",
+		values: map[string]ratio{
+			"inbound":  func(s callStats) (float64, float64) { return s.InCalls, s.InCallAttempts },
+			"outbound": func(s callStats) (float64, float64) { return s.OutCalls, s.OutCallAttempts },
+		},
+	},
+	{
+		name: "ner_ratio",
+		help: "Network effectiveness ratio of the trunk group: call attempts not failed by the network divided by call attempts.",
+		values: map[string]ratio{
+			"inbound": func(s callStats) (float64, float64) {
+				if s.failure == nil {
+					return 0, 0
+				}
+				return s.InCallAttempts - s.inNetworkFailures(), s.InCallAttempts
+			},
+			"outbound": func(s callStats) (float64, float64) {
+				if s.failure == nil {
+					return 0, 0
+				}
+				return s.OutCallAttempts - s.outNetworkFailures(), s.OutCallAttempts
+			},
+		},
+	},
+	{
+		name: "call_failure_ratio",
+		help: "Failed calls of the trunk group divided by call attempts.",
+		values: map[string]ratio{
+			"inbound": func(s callStats) (float64, float64) {
+				if s.failure == nil {
+					return 0, 0
+				}
+				return s.inFailures(), s.InCallAttempts
+			},
+			"outbound": func(s callStats) (float64, float64) {
+				if s.failure == nil {
+					return 0, 0
+				}
+				return s.outFailures(), s.OutCallAttempts
+			},
+		},
+	},
+	{
+		name: "average_call_setup_seconds",
+		help: "Average call setup time of the trunk group in seconds.",
+		values: map[string]ratio{
+			// callSetupTime is in 100ths of a second
+			"": func(s callStats) (float64, float64) { return s.CallSetupTime / 100, s.CallSetups },
+		},
+	},
+}
+
+// kpiMetrics are the derived KPI metrics of the trunk groups of the zones
+type kpiMetrics struct {
+	metrics []*prometheus.GaugeVec
+}
+
+func newKPIMetrics(registry *prometheus.Registry) *kpiMetrics {
+	m := &kpiMetrics{}
+	for _, kpi := range derivedKPIs {
+		labels := []string{"system", "addresscontext", "zone", "trunkgroup"}
+		if _, ok := kpi.values[""]; !ok {
+			labels = append(labels, "direction")
+		}
+		metric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: prometheus.BuildFQName("sonus", "trunkgroup", kpi.name),
+			Help: kpi.help,
+		}, labels)
+		registry.MustRegister(metric)
+		m.metrics = append(m.metrics, metric)
+	}
+	return m
+}
+
+// process sets the derived KPIs of the trunk groups of the zone
+func (m *kpiMetrics) process(system, context string, zone *Zone) {
+	failures := map[string]*CallFailureCurrentStatistics{}
+	for n := range zone.CallFailureCurrentStatistics {
+		failures[zone.CallFailureCurrentStatistics[n].Name] = &zone.CallFailureCurrentStatistics[n]
+	}
+	for n := range zone.CallCurrentStatistics {
+		s := callStats{&zone.CallCurrentStatistics[n], failures[zone.CallCurrentStatistics[n].Name]}
+		for i, kpi := range derivedKPIs {
+			for direction, value := range kpi.values {
+				numerator, denominator := value(s)
+				if denominator == 0 {
+					continue
+				}
+				labelValues := []string{system, context, zone.Name, s.Name}
+				if direction != "" {
+					labelValues = append(labelValues, direction)
+				}
+				m.metrics[i].WithLabelValues(labelValues...).Set(numerator / denominator)
+			}
+		}
+	}
+}
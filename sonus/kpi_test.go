@@ -0,0 +1,66 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestKPIMetrics(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <callCurrentStatistics>
+    <name>TG1</name>
+    <inCalls>60</inCalls>
+    <outCalls>30</outCalls>
+    <inCallAttempts>100</inCallAttempts>
+    <outCallAttempts>40</outCallAttempts>
+    <callSetupTime>25000</callSetupTime>
+    <callSetups>100</callSetups>
+  </callCurrentStatistics>
+  <callCurrentStatistics>
+    <name>TG2</name>
+    <inCalls>0</inCalls>
+    <inCallAttempts>0</inCallAttempts>
+    <callSetupTime>0</callSetupTime>
+    <callSetups>0</callSetups>
+  </callCurrentStatistics>
+  <callFailureCurrentStatistics>
+    <name>TG1</name>
+    <inCallFailNoRoutes>5</inCallFailNoRoutes>
+    <inCallFailNetworkFailure>5</inCallFailNetworkFailure>
+    <inCallFailInvalidCall>10</inCallFailInvalidCall>
+    <outCallFailNoResources>4</outCallFailNoResources>
+  </callFailureCurrentStatistics>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newKPIMetrics(registry).process("sbc1", "default", zone)
+
+	// TG2 has no attempts, setups or failure statistics, so it has no KPIs.
+	expected := `
+# HELP sonus_trunkgroup_asr_ratio Answer seizure ratio of the trunk group: completed calls divided by call attempts.
+# TYPE sonus_trunkgroup_asr_ratio gauge
+sonus_trunkgroup_asr_ratio{addresscontext="default",direction="inbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.6
+sonus_trunkgroup_asr_ratio{addresscontext="default",direction="outbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.75
+# HELP sonus_trunkgroup_average_call_setup_seconds Average call setup time of the trunk group in seconds.
+# TYPE sonus_trunkgroup_average_call_setup_seconds gauge
+sonus_trunkgroup_average_call_setup_seconds{addresscontext="default",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 2.5
+# HELP sonus_trunkgroup_call_failure_ratio Failed calls of the trunk group divided by call attempts.
+# TYPE sonus_trunkgroup_call_failure_ratio gauge
+sonus_trunkgroup_call_failure_ratio{addresscontext="default",direction="inbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.2
+sonus_trunkgroup_call_failure_ratio{addresscontext="default",direction="outbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.1
+# HELP sonus_trunkgroup_ner_ratio Network effectiveness ratio of the trunk group: call attempts not failed by the network divided by call attempts.
+# TYPE sonus_trunkgroup_ner_ratio gauge
+sonus_trunkgroup_ner_ratio{addresscontext="default",direction="inbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.9
+sonus_trunkgroup_ner_ratio{addresscontext="default",direction="outbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.9
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
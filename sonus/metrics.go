@@ -3,6 +3,10 @@ package sonus
 import (
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -50,6 +54,37 @@ var metricHelp = map[string]string{
 	"InRetargetRegs":             "The current number of incoming registrations that are retargeted by Load Balancing Service",                                                                                                                                                                                                                                                                                  // "inRetargetRegs"`
 	"OutRetargetCalls":           "The current number of outgoing calls that are retargeted by Load Balancing Service",                                                                                                                                                                                                                                                                                          // "outRetargetCalls"`
 	"OutRetargetRegs":            "The current number of outgoing registrations that are retargeted by Load Balancing Service",                                                                                                                                                                                                                                                                                  // "outRetargetRegs"`
+	"TotalCallsAvailable":        "The number of calls currently available on this trunk group",
+	"TotalCallsInboundReserved":  "The number of calls reserved for inbound calls on this trunk group",
+	"InboundCallsUsage":          "The number of active inbound calls on this trunk group",
+	"OutboundCallsUsage":         "The number of active outbound calls on this trunk group",
+	"TotalCallsConfigured":       "The number of calls configured on this trunk group",
+	"PriorityCallUsage":          "The number of active priority calls on this trunk group",
+	"TotalOutboundCallsReserved": "The number of calls reserved for outbound calls on this trunk group",
+	"BwCurrentLimit":             "The current bandwidth limit of this trunk group in kbit/s",
+	"BwAvailable":                "The bandwidth currently available on this trunk group in kbit/s",
+	"BwInboundUsage":             "The bandwidth used by inbound calls on this trunk group in kbit/s",
+	"BwOutboundUsage":            "The bandwidth used by outbound calls on this trunk group in kbit/s",
+	"PriorityBwUsage":            "The bandwidth used by priority calls on this trunk group in kbit/s",
+	"AllocatedBw":                "The bandwidth allocated to calls on this IP interface in kbit/s",
+	"ActualBwAvailable":          "The bandwidth available for calls on this IP interface in kbit/s",
+	"TxPackets":                  "The number of packets sent on this IP interface",
+	"RxPackets":                  "The number of packets received on this IP interface",
+	"TxErrors":                   "The number of errors sending packets on this IP interface",
+	"RxErrors":                   "The number of errors receiving packets on this IP interface",
+	"TxBytes":                    "The number of bytes sent",
+	"RxBytes":                    "The number of bytes received",
+
+	// The state-set metrics are described by metric name, as the same field
+	// names, e.g. State, are used for different states.
+	"sonus_Zone_SipSigPort_State":                      "Administrative state of the SIP signaling port of the zone, 1 for the current state.",
+	"sonus_Zone_SipSigPort_Mode":                       "Operational mode of the SIP signaling port of the zone, 1 for the current mode.",
+	"sonus_Zone_SipSigPortStatus_State":                "Service state of the SIP signaling port of the zone, 1 for the current state.",
+	"sonus_Zone_SipTrunkGroup_State":                   "Administrative state of the SIP trunk group, 1 for the current state.",
+	"sonus_Zone_SipTrunkGroup_Mode":                    "Operational mode of the SIP trunk group, 1 for the current mode.",
+	"sonus_Zone_TrunkGroupStatus_State":                "Service state of the trunk group, 1 for the current state.",
+	"sonus_Zone_TrunkGroupStatus_PacketOutDetectState": "Packet outage detection state of the trunk group, 1 for the current state.",
+	"sonus_Zone_TracerouteSigPort_State":               "State of the traceroute signaling port of the zone, 1 for the current state.",
 }
 
 func getHelp(field string) string {
@@ -60,14 +95,293 @@ func getHelp(field string) string {
 	return help
 }
 
-// BuildMetrics takes a registry and a structure and creates Gauge metrics for any float64 items.
+// metricTypes contains the fields of the Sonus response that are monotonic
+// totals.  They are exposed as counters with a "_total" suffix, all other
+// fields are exposed as gauges.
+var metricTypes = map[string]prometheus.ValueType{
+	"RcvInvite":                       prometheus.CounterValue,
+	"SndInvite":                       prometheus.CounterValue,
+	"RcvAck":                          prometheus.CounterValue,
+	"SndAck":                          prometheus.CounterValue,
+	"RcvPrack":                        prometheus.CounterValue,
+	"SndPrack":                        prometheus.CounterValue,
+	"RcvInfo":                         prometheus.CounterValue,
+	"SndInfo":                         prometheus.CounterValue,
+	"RcvRefer":                        prometheus.CounterValue,
+	"SndRefer":                        prometheus.CounterValue,
+	"RcvBye":                          prometheus.CounterValue,
+	"SndBye":                          prometheus.CounterValue,
+	"RcvCancel":                       prometheus.CounterValue,
+	"SndCancel":                       prometheus.CounterValue,
+	"RcvRegister":                     prometheus.CounterValue,
+	"SndRegister":                     prometheus.CounterValue,
+	"RcvUpdate":                       prometheus.CounterValue,
+	"SndUpdate":                       prometheus.CounterValue,
+	"RcvSubscriber":                   prometheus.CounterValue,
+	"SndSubscriber":                   prometheus.CounterValue,
+	"RcvNotify":                       prometheus.CounterValue,
+	"SndNotify":                       prometheus.CounterValue,
+	"RcvOption":                       prometheus.CounterValue,
+	"SndOption":                       prometheus.CounterValue,
+	"RcvMessage":                      prometheus.CounterValue,
+	"SndMessage":                      prometheus.CounterValue,
+	"RcvPublish":                      prometheus.CounterValue,
+	"SndPublish":                      prometheus.CounterValue,
+	"RcvNonInv2xx":                    prometheus.CounterValue,
+	"SndNonInv2xx":                    prometheus.CounterValue,
+	"RcvNonInvErr":                    prometheus.CounterValue,
+	"SndNonInvErr":                    prometheus.CounterValue,
+	"RcvUnknownMsg":                   prometheus.CounterValue,
+	"Rcv1xx":                          prometheus.CounterValue,
+	"Snd1xx":                          prometheus.CounterValue,
+	"Rcv18x":                          prometheus.CounterValue,
+	"Snd18x":                          prometheus.CounterValue,
+	"Rcv2xx":                          prometheus.CounterValue,
+	"Snd2xx":                          prometheus.CounterValue,
+	"Rcv3xx":                          prometheus.CounterValue,
+	"Snd3xx":                          prometheus.CounterValue,
+	"Rcv4xx":                          prometheus.CounterValue,
+	"Snd4xx":                          prometheus.CounterValue,
+	"Rcv5xx":                          prometheus.CounterValue,
+	"Snd5xx":                          prometheus.CounterValue,
+	"Rcv6xx":                          prometheus.CounterValue,
+	"Snd6xx":                          prometheus.CounterValue,
+	"InvReTransmit":                   prometheus.CounterValue,
+	"RegReTransmit":                   prometheus.CounterValue,
+	"ByeRetransmit":                   prometheus.CounterValue,
+	"CancelReTransmit":                prometheus.CounterValue,
+	"OtherReTransmit":                 prometheus.CounterValue,
+	"ParseError":                      prometheus.CounterValue,
+	"InUsage":                         prometheus.CounterValue,
+	"OutUsage":                        prometheus.CounterValue,
+	"InCalls":                         prometheus.CounterValue,
+	"OutCalls":                        prometheus.CounterValue,
+	"InCallAttempts":                  prometheus.CounterValue,
+	"OutCallAttempts":                 prometheus.CounterValue,
+	"CallSetupTime":                   prometheus.CounterValue,
+	"CallSetups":                      prometheus.CounterValue,
+	"RoutingAttempts":                 prometheus.CounterValue,
+	"InBwUsage":                       prometheus.CounterValue,
+	"OutBwUsage":                      prometheus.CounterValue,
+	"CallsWithPktOutage":              prometheus.CounterValue,
+	"CallsWithPktOutageAtEnd":         prometheus.CounterValue,
+	"TotalPktOutage":                  prometheus.CounterValue,
+	"PodEvents":                       prometheus.CounterValue,
+	"PlayoutBufferGood":               prometheus.CounterValue,
+	"PlayoutBufferAcceptable":         prometheus.CounterValue,
+	"PlayoutBufferPoor":               prometheus.CounterValue,
+	"PlayoutBufferUnacceptable":       prometheus.CounterValue,
+	"SipRegAttempts":                  prometheus.CounterValue,
+	"SipRegCompletions":               prometheus.CounterValue,
+	"CallsWithPsxDips":                prometheus.CounterValue,
+	"TotalPsxDips":                    prometheus.CounterValue,
+	"TotalCallUpdates":                prometheus.CounterValue,
+	"InRetargetCalls":                 prometheus.CounterValue,
+	"InRetargetRegs":                  prometheus.CounterValue,
+	"OutRetargetCalls":                prometheus.CounterValue,
+	"OutRetargetRegs":                 prometheus.CounterValue,
+	"InCallFailNoRoutes":              prometheus.CounterValue,
+	"InCallFailNoResources":           prometheus.CounterValue,
+	"InCallFailNoService":             prometheus.CounterValue,
+	"InCallFailInvalidCall":           prometheus.CounterValue,
+	"InCallFailNetworkFailure":        prometheus.CounterValue,
+	"InCallFailProtocolError":         prometheus.CounterValue,
+	"InCallFailUnspecified":           prometheus.CounterValue,
+	"OutCallFailNoRoutes":             prometheus.CounterValue,
+	"OutCallFailNoResources":          prometheus.CounterValue,
+	"OutCallFailNoService":            prometheus.CounterValue,
+	"OutCallFailInvalidCall":          prometheus.CounterValue,
+	"OutCallFailNetworkFailure":       prometheus.CounterValue,
+	"OutCallFailProtocolError":        prometheus.CounterValue,
+	"OutCallFailUnspecified":          prometheus.CounterValue,
+	"RoutingFailuresResv":             prometheus.CounterValue,
+	"NoPsxRoute":                      prometheus.CounterValue,
+	"CallFailPolicing":                prometheus.CounterValue,
+	"CallAbandoned":                   prometheus.CounterValue,
+	"RegCallsFailed":                  prometheus.CounterValue,
+	"InvalidSPCallsFailed":            prometheus.CounterValue,
+	"NonMatchSrcIpCallsFail":          prometheus.CounterValue,
+	"SecurityFail":                    prometheus.CounterValue,
+	"AllocFailCallLimit":              prometheus.CounterValue,
+	"AllocFailBwLimit":                prometheus.CounterValue,
+	"AllocFailParentConstraint":       prometheus.CounterValue,
+	"SipRegFailPolicing":              prometheus.CounterValue,
+	"SipRegFailOther":                 prometheus.CounterValue,
+	"SipRegFailInternal":              prometheus.CounterValue,
+	"SipSubsFailPolicing":             prometheus.CounterValue,
+	"SipOtherReqFailPolicing":         prometheus.CounterValue,
+	"SipOtherReqFailOther":            prometheus.CounterValue,
+	"SipOtherReqFailInternal":         prometheus.CounterValue,
+	"OptionsPolicerReject":            prometheus.CounterValue,
+	"HpcAccept":                       prometheus.CounterValue,
+	"InHpcAccept":                     prometheus.CounterValue,
+	"OutHpcAccept":                    prometheus.CounterValue,
+	"HpcOverloadExempt":               prometheus.CounterValue,
+	"Hpc403Out":                       prometheus.CounterValue,
+	"EmergencyAccept":                 prometheus.CounterValue,
+	"EmergencyRejectPolicer":          prometheus.CounterValue,
+	"EmergencyRejectBWCall":           prometheus.CounterValue,
+	"EmergencyOODAccept":              prometheus.CounterValue,
+	"EmergencyOODRejectPolicer":       prometheus.CounterValue,
+	"EmergencyRegAccept":              prometheus.CounterValue,
+	"EmergencyRegRejectPolicer":       prometheus.CounterValue,
+	"EmergencyRegRejectLimit":         prometheus.CounterValue,
+	"EmergencySubsAccept":             prometheus.CounterValue,
+	"EmergencySubsRejectPolicer":      prometheus.CounterValue,
+	"EmergencySubsRejectLimit":        prometheus.CounterValue,
+	"NumberOfCallsSendingAARs":        prometheus.CounterValue,
+	"NumberOfTotalAARSent":            prometheus.CounterValue,
+	"NumberOfTimeoutOrErrorAAR":       prometheus.CounterValue,
+	"NumberOfReceivedAAASuccesses":    prometheus.CounterValue,
+	"NumberOfReceivedAAAFailures":     prometheus.CounterValue,
+	"NumberOfReceivedRARs":            prometheus.CounterValue,
+	"NumberOfReceivedASRs":            prometheus.CounterValue,
+	"NumberOfSentSTRs":                prometheus.CounterValue,
+	"NumberOfTotalUDRSent":            prometheus.CounterValue,
+	"NumberOfTimeoutOrErrorUDR":       prometheus.CounterValue,
+	"NumberOfReceivedUDASuccesses":    prometheus.CounterValue,
+	"NumberOfReceivedUDAFailures":     prometheus.CounterValue,
+	"TotNumOfS8hrOutbndReg":           prometheus.CounterValue,
+	"NumOfS8hrOutbndRegSuc":           prometheus.CounterValue,
+	"NumOfS8hrOutbndRegFail":          prometheus.CounterValue,
+	"TotNumOfS8hrOutbndNormalCall":    prometheus.CounterValue,
+	"NumOfS8hrOutbndNormalCallSuc":    prometheus.CounterValue,
+	"NumOfS8hrOutbndNormalCallFail":   prometheus.CounterValue,
+	"NumOfS8hrOutbndEmgCallRej":       prometheus.CounterValue,
+	"NumOfS8hrInboundRegSuc":          prometheus.CounterValue,
+	"NumOfS8hrInboundRegFail":         prometheus.CounterValue,
+	"NumOfS8hrInboundEmgCallSuc":      prometheus.CounterValue,
+	"NumOfS8hrInboundEmgCallFail":     prometheus.CounterValue,
+	"OrigCalls":                       prometheus.CounterValue,
+	"TermCalls":                       prometheus.CounterValue,
+	"TxPdus":                          prometheus.CounterValue,
+	"RxPdus":                          prometheus.CounterValue,
+	"TxBytes":                         prometheus.CounterValue,
+	"RxBytes":                         prometheus.CounterValue,
+	"TxPackets":                       prometheus.CounterValue,
+	"RxPackets":                       prometheus.CounterValue,
+	"TxErrors":                        prometheus.CounterValue,
+	"RxErrors":                        prometheus.CounterValue,
+	"InRegs":                          prometheus.CounterValue,
+	"OutRegs":                         prometheus.CounterValue,
+	"Tx500s":                          prometheus.CounterValue,
+	"Tx503s":                          prometheus.CounterValue,
+	"BytesSent":                       prometheus.CounterValue,
+	"BytesRcvd":                       prometheus.CounterValue,
+	"QosDropCount":                    prometheus.CounterValue,
+	"TotalServerSessions":             prometheus.CounterValue,
+	"TotalServerConnections":          prometheus.CounterValue,
+	"TotalClientConnections":          prometheus.CounterValue,
+	"TotalTcpConnection":              prometheus.CounterValue,
+	"TotalTlsTcpConnection":           prometheus.CounterValue,
+	"SessionsInitiated":               prometheus.CounterValue,
+	"SessionsCompleted":               prometheus.CounterValue,
+	"SessionsCompletedDueToTimeout":   prometheus.CounterValue,
+	"SessionsAbortedDueToTraffic":     prometheus.CounterValue,
+	"SessionsReachedRelearnThreshold": prometheus.CounterValue,
+	"SessionAdmissionReject":          prometheus.CounterValue,
+	"PortRangeRegistrationFailures":   prometheus.CounterValue,
+	"SessionResumptions":              prometheus.CounterValue,
+	"NoCipherSuite":                   prometheus.CounterValue,
+	"NoClientCert":                    prometheus.CounterValue,
+	"HandshakeTimeouts":               prometheus.CounterValue,
+	"HigherAuthTimeout":               prometheus.CounterValue,
+	"ClientAuthFailures":              prometheus.CounterValue,
+	"ServerAuthFailures":              prometheus.CounterValue,
+	"FatelAlertsReceived":             prometheus.CounterValue,
+	"WarningAlertsReceived":           prometheus.CounterValue,
+	"HandshakeFailures":               prometheus.CounterValue,
+	"ReceiveFailures":                 prometheus.CounterValue,
+	"SendFailures":                    prometheus.CounterValue,
+	"NoAuthDrops":                     prometheus.CounterValue,
+	"NoAuth488":                       prometheus.CounterValue,
+	"MidConnectionHello":              prometheus.CounterValue,
+	"ValidationFailures":              prometheus.CounterValue,
+}
+
+// metricStates contains the known values of the enumerated string fields of
+// the Sonus response, keyed by metric name.  The fields are exposed as state-set
+// gauges with a state label that is 1 for the current value and 0 for the other
+// known values.  A value that is not listed is exposed as well.
+var metricStates = map[string][]string{
+	"sonus_Zone_SipSigPort_State":                      {"enabled", "disabled"},
+	"sonus_Zone_SipSigPort_Mode":                       {"inService", "outOfService"},
+	"sonus_Zone_SipSigPortStatus_State":                {"inService", "outOfService"},
+	"sonus_Zone_SipTrunkGroup_State":                   {"enabled", "disabled"},
+	"sonus_Zone_SipTrunkGroup_Mode":                    {"inService", "outOfService"},
+	"sonus_Zone_TrunkGroupStatus_State":                {"inService", "outOfService"},
+	"sonus_Zone_TrunkGroupStatus_PacketOutDetectState": {"normal", "packetOutageDetected"},
+	"sonus_Zone_TracerouteSigPort_State":               {"enabled", "disabled"},
+}
+
+// getValueType returns the metric type of the field
+func getValueType(field string) prometheus.ValueType {
+	if typ, ok := metricTypes[field]; ok {
+		return typ
+	}
+	return prometheus.GaugeValue
+}
+
+// MetricVec is a metric family set from the statistics read from the SBC.
+// The values are kept by label values and exposed as constant metrics of the
+// value type, as a prometheus.CounterVec can only be incremented.
+type MetricVec struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	mu        sync.Mutex
+	values    map[string]metricValue
+}
+
+type metricValue struct {
+	labelValues []string
+	value       float64
+}
+
+// NewMetricVec creates a MetricVec of the value type
+func NewMetricVec(name, help string, valueType prometheus.ValueType, labels []string) *MetricVec {
+	return &MetricVec{
+		desc:      prometheus.NewDesc(name, help, labels, nil),
+		valueType: valueType,
+		values:    map[string]metricValue{},
+	}
+}
+
+// Set sets the value for the label values
+func (v *MetricVec) Set(value float64, labelValues ...string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.values[strings.Join(labelValues, "\xff")] = metricValue{labelValues: labelValues, value: value}
+}
+
+// Describe implements prometheus.Collector
+func (v *MetricVec) Describe(ch chan<- *prometheus.Desc) {
+	ch <- v.desc
+}
+
+// Collect implements prometheus.Collector
+func (v *MetricVec) Collect(ch chan<- prometheus.Metric) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for _, mv := range v.values {
+		m, err := prometheus.NewConstMetric(v.desc, v.valueType, mv.value, mv.labelValues...)
+		if err != nil {
+			m = prometheus.NewInvalidMetric(v.desc, err)
+		}
+		ch <- m
+	}
+}
+
+// BuildMetrics takes a registry and a structure and creates metrics for any float64 items.
 // The metric names are based on the fields in the structure and any substructures.
 // eg. structname_structname_fieldName
+// Fields listed in metricTypes as counters get a "_total" suffix, all others are gauges.
+// The metrics of interval statistics are gauges with an additional interval label.
+// String fields listed in metricStates are state-set gauges with a state label.
 //
-// The returned map is keyed  by the metric name
-func BuildMetrics(registry *prometheus.Registry, t reflect.Type) map[string]*prometheus.GaugeVec {
+// The returned map is keyed  by the metric name without the suffix
+func BuildMetrics(registry *prometheus.Registry, t reflect.Type) map[string]*MetricVec {
 	ch := make(chan *Metric)
-	stats := map[string]*prometheus.GaugeVec{}
+	stats := map[string]*MetricVec{}
 	go func() {
 		examiner(ch, "sonus", t)
 		close(ch)
@@ -88,23 +402,122 @@ func examiner(ch chan *Metric, sub string, t reflect.Type) {
 	case reflect.Array, reflect.Chan, reflect.Map, reflect.Ptr, reflect.Slice:
 		examiner(ch, fmt.Sprintf("%s_%s", sub, t.Name()), t.Elem())
 	case reflect.Struct:
+		interval := isInterval(t)
 		for i := 0; i < t.NumField(); i++ {
 			f := t.Field(i)
+			if skipMetric(f) {
+				continue
+			}
 			switch f.Type.Kind() {
 			case reflect.Slice:
 				examiner(ch, fmt.Sprintf("%s_%s", sub, f.Name), f.Type.Elem())
 			case reflect.Float64:
-				// ch <- fmt.Sprintf("%s_%s (%s)\n", sub, f.Name, f.Type.Kind().String())
-				metric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
-					Namespace: sub,
-					Name:      f.Name,
-					Help:      getHelp(f.Name),
-				}, []string{"system", "addresscontext", "zone", "trunkgroup"})
-				m := &Metric{Name: fmt.Sprintf("%s_%s", sub, f.Name), Metric: metric}
-				ch <- m
+				name := fmt.Sprintf("%s_%s", sub, f.Name)
+				labels := []string{"system", "addresscontext", "zone", "trunkgroup"}
+				valueType := getValueType(f.Name)
+				if interval {
+					labels = append(labels, "interval")
+					valueType = prometheus.GaugeValue
+				}
+				fqName := name
+				if valueType == prometheus.CounterValue {
+					fqName += "_total"
+				}
+				metric := NewMetricVec(fqName, getHelp(f.Name), valueType, labels)
+				ch <- &Metric{Name: name, Metric: metric}
+			case reflect.String:
+				name := fmt.Sprintf("%s_%s", sub, f.Name)
+				if _, ok := metricStates[name]; !ok {
+					continue
+				}
+				labels := []string{"system", "addresscontext", "zone", "trunkgroup", "state"}
+				metric := NewMetricVec(name, getHelp(name), prometheus.GaugeValue, labels)
+				ch <- &Metric{Name: name, Metric: metric}
 			case reflect.Struct:
 				examiner(ch, fmt.Sprintf("%s_%s", sub, f.Name), f.Type)
 			}
 		}
 	}
 }
+
+// isInterval reports whether t holds the statistics of one interval, which are
+// identified by the interval number and valid flag.
+func isInterval(t reflect.Type) bool {
+	_, number := t.FieldByName("Number")
+	_, valid := t.FieldByName("IntervalValid")
+	return number && valid
+}
+
+// skipMetric reports whether the field is tagged `metric:"-"` because its
+// metrics are built by a dedicated function, e.g. to add labels from its fields.
+func skipMetric(f reflect.StructField) bool {
+	return f.Tag.Get("metric") == "-"
+}
+
+// fieldMetrics are the metrics of the float64 fields of a statistics
+// structure, keyed by field name.  Unlike BuildMetrics, the metric names are
+// the snake case field names with a fixed prefix, and the labels are chosen
+// by the caller.
+type fieldMetrics map[string]*MetricVec
+
+// newFieldMetrics creates and registers the metrics of the float64 fields of
+// the structure t.  Interval statistics get an additional interval label.
+func newFieldMetrics(registry *prometheus.Registry, prefix string, t reflect.Type, labels []string) fieldMetrics {
+	interval := isInterval(t)
+	if interval {
+		labels = append(labels[:len(labels):len(labels)], "interval")
+	}
+	m := fieldMetrics{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type.Kind() != reflect.Float64 {
+			continue
+		}
+		valueType := getValueType(f.Name)
+		if interval {
+			valueType = prometheus.GaugeValue
+		}
+		name := fmt.Sprintf("%s_%s", prefix, snakeCase(f.Name))
+		if valueType == prometheus.CounterValue {
+			name += "_total"
+		}
+		m[f.Name] = NewMetricVec(name, getHelp(f.Name), valueType, labels)
+		registry.MustRegister(m[f.Name])
+	}
+	return m
+}
+
+// set sets the metrics from the fields of the structure v.  Interval
+// statistics the SBC marks as not valid are skipped.
+func (m fieldMetrics) set(v reflect.Value, labelValues ...string) {
+	t := v.Type()
+	if isInterval(t) {
+		valid, _ := strconv.ParseBool(v.FieldByName("IntervalValid").String())
+		if !valid {
+			return
+		}
+		labelValues = append(labelValues[:len(labelValues):len(labelValues)], v.FieldByName("Number").String())
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if metric, ok := m[t.Field(i).Name]; ok {
+			metric.Set(v.Field(i).Float(), labelValues...)
+		}
+	}
+}
+
+// snakeCase converts a field name to a metric name, e.g. InboundCPS to inbound_cps.
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
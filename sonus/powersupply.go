@@ -43,7 +43,11 @@ func boolToMetric(b bool) float64 {
 	return 0
 }
 
-func PowerMetrics(ctx context.Context, sbc *SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error {
+func init() {
+	RegisterCollector("power", PowerMetrics)
+}
+
+func PowerMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
 	var (
 		PowerSupply_Power_Fault = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "sonus_powersupply_powerfault",
@@ -0,0 +1,82 @@
+package sonus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// qoeMetrics are the sonus_trunkgroup_qoe_* metrics of the trunk groups of
+// the zones.  The values since SBXBOOT are cumulative since the SBC started
+// and are kept in their own families, so they are not mistaken for the
+// current values.
+type qoeMetrics struct {
+	rFactor             *MetricVec
+	rFactorSinceBoot    *MetricVec
+	rFactorBreaches     *MetricVec
+	asr                 *MetricVec
+	asrSinceBoot        *MetricVec
+	asrBreaches         *MetricVec
+	sustainedCallRate   *MetricVec
+	activeCalls         *MetricVec
+	postGatewayRingTime *MetricVec
+	qosDrops            *MetricVec
+}
+
+func newQoEMetrics(registry *prometheus.Registry) *qoeMetrics {
+	labels := []string{"system", "addresscontext", "zone", "trunkgroup"}
+	directionLabels := append(labels[:len(labels):len(labels)], "direction")
+	name := func(name string) string { return prometheus.BuildFQName("sonus", "trunkgroup_qoe", name) }
+	m := &qoeMetrics{
+		rFactor: NewMetricVec(name("rfactor"),
+			"Current R-factor of the calls of the trunk group.", prometheus.GaugeValue, directionLabels),
+		rFactorSinceBoot: NewMetricVec(name("rfactor_since_boot"),
+			"R-factor of the calls of the trunk group since the SBC started.", prometheus.GaugeValue, directionLabels),
+		rFactorBreaches: NewMetricVec(name("rfactor_threshold_breaches_total"),
+			"Number of times the R-factor of the trunk group breached the major or critical threshold.", prometheus.CounterValue,
+			append(directionLabels[:len(directionLabels):len(directionLabels)], "severity")),
+		asr: NewMetricVec(name("asr_ratio"),
+			"Current answer seizure ratio of the egress calls of the trunk group.", prometheus.GaugeValue, labels),
+		asrSinceBoot: NewMetricVec(name("asr_since_boot_ratio"),
+			"Answer seizure ratio of the egress calls of the trunk group since the SBC started.", prometheus.GaugeValue, labels),
+		asrBreaches: NewMetricVec(name("asr_threshold_breaches_total"),
+			"Number of times the answer seizure ratio of the trunk group exceeded the major or critical threshold.", prometheus.CounterValue,
+			append(labels[:len(labels):len(labels)], "severity")),
+		sustainedCallRate: NewMetricVec(name("egress_sustained_call_rate"),
+			"Sustained egress call rate of the trunk group in calls per second.", prometheus.GaugeValue, labels),
+		activeCalls: NewMetricVec(name("egress_active_calls"),
+			"Number of active egress calls of the trunk group.", prometheus.GaugeValue, labels),
+		postGatewayRingTime: NewMetricVec(name("post_gateway_ringing_delay_seconds"),
+			"Current post gateway ringing delay of the trunk group in seconds.", prometheus.GaugeValue, labels),
+		qosDrops: NewMetricVec(name("drops_total"),
+			"Number of calls of the trunk group dropped by QoS call admission.", prometheus.CounterValue, labels),
+	}
+	registry.MustRegister(m.rFactor, m.rFactorSinceBoot, m.rFactorBreaches, m.asr, m.asrSinceBoot, m.asrBreaches,
+		m.sustainedCallRate, m.activeCalls, m.postGatewayRingTime, m.qosDrops)
+	return m
+}
+
+// process sets the QoE metrics of the trunk groups of the zone.  The SBC
+// reports the ASR in percent and the PGRD in milliseconds.
+func (m *qoeMetrics) process(system, context string, zone *Zone) {
+	for _, s := range zone.TrunkGroupQoeStatus {
+		labelValues := []string{system, context, zone.Name, s.Name}
+
+		m.rFactor.Set(s.InboundRFactor, append(labelValues, "inbound")...)
+		m.rFactor.Set(s.OutboundRFactor, append(labelValues, "outbound")...)
+		m.rFactorSinceBoot.Set(s.InboundRFactorFromSBXBOOT, append(labelValues, "inbound")...)
+		m.rFactorSinceBoot.Set(s.OutboundRFactorFromSBXBOOT, append(labelValues, "outbound")...)
+		m.rFactorBreaches.Set(s.InboundRFactorNumMajorThresholdBreached, append(labelValues, "inbound", "major")...)
+		m.rFactorBreaches.Set(s.InboundRFactorNumCriticalThresholdBreached, append(labelValues, "inbound", "critical")...)
+		m.rFactorBreaches.Set(s.OutboundRFactorNumMajorThresholdBreached, append(labelValues, "outbound", "major")...)
+		m.rFactorBreaches.Set(s.OutboundRFactorNumCriticalThresholdBreached, append(labelValues, "outbound", "critical")...)
+
+		m.asr.Set(s.CurrentASR/100, labelValues...)
+		m.asrSinceBoot.Set(s.AsrFromSBXBOOT/100, labelValues...)
+		m.asrBreaches.Set(s.AsrMajorThresholdExceeded, append(labelValues, "major")...)
+		m.asrBreaches.Set(s.AsrCriticalThresholdExceeded, append(labelValues, "critical")...)
+
+		m.sustainedCallRate.Set(s.EgressSustainedCallRate, labelValues...)
+		m.activeCalls.Set(s.EgressActiveCalls, labelValues...)
+		m.postGatewayRingTime.Set(s.CurrentPgrd/1000, labelValues...)
+		m.qosDrops.Set(s.QosDropCount, labelValues...)
+	}
+}
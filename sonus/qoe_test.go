@@ -0,0 +1,76 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestQoEMetrics(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <trunkGroupQoeStatus>
+    <name>TG1</name>
+    <inboundRFactor>92</inboundRFactor>
+    <inboundRFactorFromSBXBOOT>90</inboundRFactorFromSBXBOOT>
+    <inboundRFactorNumCriticalThresholdBreached>1</inboundRFactorNumCriticalThresholdBreached>
+    <inboundRFactorNumMajorThresholdBreached>3</inboundRFactorNumMajorThresholdBreached>
+    <outboundRFactor>88</outboundRFactor>
+    <outboundRFactorFromSBXBOOT>89</outboundRFactorFromSBXBOOT>
+    <outboundRFactorNumCriticalThresholdBreached>0</outboundRFactorNumCriticalThresholdBreached>
+    <outboundRFactorNumMajorThresholdBreached>2</outboundRFactorNumMajorThresholdBreached>
+    <currentASR>55</currentASR>
+    <asrFromSBXBOOT>60</asrFromSBXBOOT>
+    <asrCriticalThresholdExceeded>0</asrCriticalThresholdExceeded>
+    <asrMajorThresholdExceeded>4</asrMajorThresholdExceeded>
+    <egressSustainedCallRate>12</egressSustainedCallRate>
+    <egressActiveCalls>300</egressActiveCalls>
+    <currentPgrd>1500</currentPgrd>
+    <qosDropCount>7</qosDropCount>
+  </trunkGroupQoeStatus>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newQoEMetrics(registry).process("sbc1", "default", zone)
+
+	expected := `
+# HELP sonus_trunkgroup_qoe_asr_ratio Current answer seizure ratio of the egress calls of the trunk group.
+# TYPE sonus_trunkgroup_qoe_asr_ratio gauge
+sonus_trunkgroup_qoe_asr_ratio{addresscontext="default",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.55
+# HELP sonus_trunkgroup_qoe_asr_since_boot_ratio Answer seizure ratio of the egress calls of the trunk group since the SBC started.
+# TYPE sonus_trunkgroup_qoe_asr_since_boot_ratio gauge
+sonus_trunkgroup_qoe_asr_since_boot_ratio{addresscontext="default",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0.6
+# HELP sonus_trunkgroup_qoe_asr_threshold_breaches_total Number of times the answer seizure ratio of the trunk group exceeded the major or critical threshold.
+# TYPE sonus_trunkgroup_qoe_asr_threshold_breaches_total counter
+sonus_trunkgroup_qoe_asr_threshold_breaches_total{addresscontext="default",severity="critical",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_trunkgroup_qoe_asr_threshold_breaches_total{addresscontext="default",severity="major",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 4
+# HELP sonus_trunkgroup_qoe_post_gateway_ringing_delay_seconds Current post gateway ringing delay of the trunk group in seconds.
+# TYPE sonus_trunkgroup_qoe_post_gateway_ringing_delay_seconds gauge
+sonus_trunkgroup_qoe_post_gateway_ringing_delay_seconds{addresscontext="default",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1.5
+# HELP sonus_trunkgroup_qoe_rfactor Current R-factor of the calls of the trunk group.
+# TYPE sonus_trunkgroup_qoe_rfactor gauge
+sonus_trunkgroup_qoe_rfactor{addresscontext="default",direction="inbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 92
+sonus_trunkgroup_qoe_rfactor{addresscontext="default",direction="outbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 88
+# HELP sonus_trunkgroup_qoe_rfactor_since_boot R-factor of the calls of the trunk group since the SBC started.
+# TYPE sonus_trunkgroup_qoe_rfactor_since_boot gauge
+sonus_trunkgroup_qoe_rfactor_since_boot{addresscontext="default",direction="inbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 90
+sonus_trunkgroup_qoe_rfactor_since_boot{addresscontext="default",direction="outbound",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 89
+# HELP sonus_trunkgroup_qoe_rfactor_threshold_breaches_total Number of times the R-factor of the trunk group breached the major or critical threshold.
+# TYPE sonus_trunkgroup_qoe_rfactor_threshold_breaches_total counter
+sonus_trunkgroup_qoe_rfactor_threshold_breaches_total{addresscontext="default",direction="inbound",severity="critical",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1
+sonus_trunkgroup_qoe_rfactor_threshold_breaches_total{addresscontext="default",direction="inbound",severity="major",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 3
+sonus_trunkgroup_qoe_rfactor_threshold_breaches_total{addresscontext="default",direction="outbound",severity="critical",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_trunkgroup_qoe_rfactor_threshold_breaches_total{addresscontext="default",direction="outbound",severity="major",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_trunkgroup_qoe_asr_ratio", "sonus_trunkgroup_qoe_asr_since_boot_ratio", "sonus_trunkgroup_qoe_asr_threshold_breaches_total",
+		"sonus_trunkgroup_qoe_post_gateway_ringing_delay_seconds", "sonus_trunkgroup_qoe_rfactor",
+		"sonus_trunkgroup_qoe_rfactor_since_boot", "sonus_trunkgroup_qoe_rfactor_threshold_breaches_total"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,34 @@
+package sonus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// sipArsStates are the address reachability service states of a SIP endpoint
+var sipArsStates = []string{"blacklisted", "whitelisted"}
+
+// sipArsMetrics is the sonus_sip_ars_endpoint_state metric of the SIP
+// endpoints monitored by the address reachability service.  Every endpoint is
+// its own series, so a blacklisted endpoint is not hidden by the other
+// endpoints of the zone.
+type sipArsMetrics struct {
+	state *MetricVec
+}
+
+func newSIPArsMetrics(registry *prometheus.Registry) *sipArsMetrics {
+	m := &sipArsMetrics{
+		state: NewMetricVec(prometheus.BuildFQName("sonus", "sip_ars", "endpoint_state"),
+			"Address reachability service state of the SIP endpoint, 1 for the current state.", prometheus.GaugeValue,
+			[]string{"system", "addresscontext", "zone", "sig_port", "endpoint_address", "endpoint_port", "state"}),
+	}
+	registry.MustRegister(m.state)
+	return m
+}
+
+// process sets the ARS state of the SIP endpoints of the zone
+func (m *sipArsMetrics) process(system, context string, zone *Zone) {
+	for _, s := range zone.SipArsStatus {
+		setStates(m.state, sipArsStates, s.EndpointArsState,
+			system, context, zone.Name, s.SigPortNum, s.EndpointIpAddress, s.EndpointIpPortNum)
+	}
+}
@@ -0,0 +1,48 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestSIPArsMetrics(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <sipArsStatus>
+    <sigPortNum>1</sigPortNum>
+    <endpointIpAddress>10.0.0.1</endpointIpAddress>
+    <endpointIpPortNum>5060</endpointIpPortNum>
+    <endpointArsState>blacklisted</endpointArsState>
+  </sipArsStatus>
+  <sipArsStatus>
+    <sigPortNum>1</sigPortNum>
+    <endpointIpAddress>10.0.0.2</endpointIpAddress>
+    <endpointIpPortNum>5060</endpointIpPortNum>
+    <endpointArsState>whitelisted</endpointArsState>
+  </sipArsStatus>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newSIPArsMetrics(registry).process("sbc1", "default", zone)
+
+	// The blacklisted endpoint is kept after the whitelisted one is processed
+	expected := `
+# HELP sonus_sip_ars_endpoint_state Address reachability service state of the SIP endpoint, 1 for the current state.
+# TYPE sonus_sip_ars_endpoint_state gauge
+sonus_sip_ars_endpoint_state{addresscontext="default",endpoint_address="10.0.0.1",endpoint_port="5060",sig_port="1",state="blacklisted",system="sbc1",zone="ZONE_A"} 1
+sonus_sip_ars_endpoint_state{addresscontext="default",endpoint_address="10.0.0.1",endpoint_port="5060",sig_port="1",state="unknown",system="sbc1",zone="ZONE_A"} 0
+sonus_sip_ars_endpoint_state{addresscontext="default",endpoint_address="10.0.0.1",endpoint_port="5060",sig_port="1",state="whitelisted",system="sbc1",zone="ZONE_A"} 0
+sonus_sip_ars_endpoint_state{addresscontext="default",endpoint_address="10.0.0.2",endpoint_port="5060",sig_port="1",state="blacklisted",system="sbc1",zone="ZONE_A"} 0
+sonus_sip_ars_endpoint_state{addresscontext="default",endpoint_address="10.0.0.2",endpoint_port="5060",sig_port="1",state="unknown",system="sbc1",zone="ZONE_A"} 0
+sonus_sip_ars_endpoint_state{addresscontext="default",endpoint_address="10.0.0.2",endpoint_port="5060",sig_port="1",state="whitelisted",system="sbc1",zone="ZONE_A"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
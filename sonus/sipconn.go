@@ -0,0 +1,104 @@
+package sonus
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+// sipConnection is the aggregated status of the SIP signaling connections to a
+// peer address, or of a single connection
+type sipConnection struct {
+	labelValues   []string
+	bytesSent     float64
+	bytesRcvd     float64
+	pduSendQueued float64
+	pduRecvQueued float64
+}
+
+// sipConnMetrics are the sonus_sip_connection* metrics of the SIP signaling
+// connections of the zones
+type sipConnMetrics struct {
+	cfg           config.SipConnections
+	connections   *prometheus.GaugeVec
+	bytesSent     *MetricVec
+	bytesRcvd     *MetricVec
+	pduSendQueued *MetricVec
+	pduRecvQueued *MetricVec
+}
+
+func newSIPConnMetrics(registry *prometheus.Registry, cfg config.SipConnections) *sipConnMetrics {
+	labels := []string{"system", "addresscontext", "zone", "peer_address", "transport", "role"}
+	if cfg.PerConnection {
+		labels = append(labels, "peer_port", "connection_id")
+	}
+	m := &sipConnMetrics{
+		cfg: cfg,
+		connections: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: prometheus.BuildFQName("sonus", "sip", "connections"),
+			Help: "Number of SIP signaling connections of the zone, by transport, role and state.",
+		}, []string{"system", "addresscontext", "zone", "transport", "role", "state"}),
+		bytesSent: NewMetricVec(prometheus.BuildFQName("sonus", "sip_connection", "bytes_sent_total"),
+			"Bytes sent on the SIP signaling connections to the peer.", prometheus.CounterValue, labels),
+		bytesRcvd: NewMetricVec(prometheus.BuildFQName("sonus", "sip_connection", "bytes_received_total"),
+			"Bytes received on the SIP signaling connections from the peer.", prometheus.CounterValue, labels),
+		pduSendQueued: NewMetricVec(prometheus.BuildFQName("sonus", "sip_connection", "pdu_send_queued"),
+			"PDUs queued for sending on the SIP signaling connections to the peer.", prometheus.GaugeValue, labels),
+		pduRecvQueued: NewMetricVec(prometheus.BuildFQName("sonus", "sip_connection", "pdu_receive_queued"),
+			"PDUs queued for receiving on the SIP signaling connections from the peer.", prometheus.GaugeValue, labels),
+	}
+	registry.MustRegister(m.connections, m.bytesSent, m.bytesRcvd, m.pduSendQueued, m.pduRecvQueued)
+	return m
+}
+
+// process sets the metrics of the SIP signaling connections of the zone.  The
+// number of connections is always complete, the per peer or per connection
+// metrics are limited to the configured number of series with the most queued
+// PDUs and bytes.
+func (m *sipConnMetrics) process(system, context string, zone *Zone) {
+	counts := map[[3]string]float64{}
+	index := map[string]*sipConnection{}
+	conns := []*sipConnection{}
+	for _, c := range zone.SipSigConnStatus {
+		counts[[3]string{c.Transport, c.Role, c.State}]++
+
+		labelValues := []string{system, context, zone.Name, c.PeerIpAddress, c.Transport, c.Role}
+		if m.cfg.PerConnection {
+			labelValues = append(labelValues, c.PeerPortNum, c.ConnectionId)
+		}
+		key := strings.Join(labelValues, "\xff")
+		conn, ok := index[key]
+		if !ok {
+			conn = &sipConnection{labelValues: labelValues}
+			index[key] = conn
+			conns = append(conns, conn)
+		}
+		conn.bytesSent += c.BytesSent
+		conn.bytesRcvd += c.BytesRcvd
+		conn.pduSendQueued += c.PduSendQueued
+		conn.pduRecvQueued += c.PduRecvQueued
+	}
+
+	for k, count := range counts {
+		m.connections.WithLabelValues(system, context, zone.Name, k[0], k[1], k[2]).Set(count)
+	}
+
+	sort.SliceStable(conns, func(i, j int) bool {
+		qi, qj := conns[i].pduSendQueued+conns[i].pduRecvQueued, conns[j].pduSendQueued+conns[j].pduRecvQueued
+		if qi != qj {
+			return qi > qj
+		}
+		return conns[i].bytesSent+conns[i].bytesRcvd > conns[j].bytesSent+conns[j].bytesRcvd
+	})
+	if m.cfg.MaxSeries > 0 && len(conns) > m.cfg.MaxSeries {
+		conns = conns[:m.cfg.MaxSeries]
+	}
+	for _, conn := range conns {
+		m.bytesSent.Set(conn.bytesSent, conn.labelValues...)
+		m.bytesRcvd.Set(conn.bytesRcvd, conn.labelValues...)
+		m.pduSendQueued.Set(conn.pduSendQueued, conn.labelValues...)
+		m.pduRecvQueued.Set(conn.pduRecvQueued, conn.labelValues...)
+	}
+}
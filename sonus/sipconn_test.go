@@ -0,0 +1,98 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+const sipConnZoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <sipSigConnStatus>
+    <connectionId>1</connectionId>
+    <peerIpAddress>10.0.0.1</peerIpAddress>
+    <peerPortNum>5061</peerPortNum>
+    <transport>tls</transport>
+    <state>connected</state>
+    <role>client</role>
+    <bytesSent>100</bytesSent>
+    <bytesRcvd>200</bytesRcvd>
+    <pduSendQueued>0</pduSendQueued>
+    <pduRecvQueued>0</pduRecvQueued>
+  </sipSigConnStatus>
+  <sipSigConnStatus>
+    <connectionId>2</connectionId>
+    <peerIpAddress>10.0.0.1</peerIpAddress>
+    <peerPortNum>5062</peerPortNum>
+    <transport>tls</transport>
+    <state>connected</state>
+    <role>client</role>
+    <bytesSent>50</bytesSent>
+    <bytesRcvd>25</bytesRcvd>
+    <pduSendQueued>1</pduSendQueued>
+    <pduRecvQueued>0</pduRecvQueued>
+  </sipSigConnStatus>
+  <sipSigConnStatus>
+    <connectionId>3</connectionId>
+    <peerIpAddress>10.0.0.2</peerIpAddress>
+    <peerPortNum>5060</peerPortNum>
+    <transport>tcp</transport>
+    <state>connecting</state>
+    <role>server</role>
+    <bytesSent>5000</bytesSent>
+    <bytesRcvd>5000</bytesRcvd>
+    <pduSendQueued>0</pduSendQueued>
+    <pduRecvQueued>0</pduRecvQueued>
+  </sipSigConnStatus>
+</zone>`
+
+func TestSIPConnMetrics(t *testing.T) {
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(sipConnZoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newSIPConnMetrics(registry, config.SipConnections{MaxSeries: 1}).process("sbc1", "default", zone)
+
+	// The connections to 10.0.0.1 are aggregated and have queued PDUs, so they
+	// are kept over 10.0.0.2, which is only counted.
+	expected := `
+# HELP sonus_sip_connection_bytes_sent_total Bytes sent on the SIP signaling connections to the peer.
+# TYPE sonus_sip_connection_bytes_sent_total counter
+sonus_sip_connection_bytes_sent_total{addresscontext="default",peer_address="10.0.0.1",role="client",system="sbc1",transport="tls",zone="ZONE_A"} 150
+# HELP sonus_sip_connection_pdu_send_queued PDUs queued for sending on the SIP signaling connections to the peer.
+# TYPE sonus_sip_connection_pdu_send_queued gauge
+sonus_sip_connection_pdu_send_queued{addresscontext="default",peer_address="10.0.0.1",role="client",system="sbc1",transport="tls",zone="ZONE_A"} 1
+# HELP sonus_sip_connections Number of SIP signaling connections of the zone, by transport, role and state.
+# TYPE sonus_sip_connections gauge
+sonus_sip_connections{addresscontext="default",role="client",state="connected",system="sbc1",transport="tls",zone="ZONE_A"} 2
+sonus_sip_connections{addresscontext="default",role="server",state="connecting",system="sbc1",transport="tcp",zone="ZONE_A"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_sip_connections", "sonus_sip_connection_bytes_sent_total", "sonus_sip_connection_pdu_send_queued"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSIPConnMetricsPerConnection(t *testing.T) {
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(sipConnZoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newSIPConnMetrics(registry, config.SipConnections{PerConnection: true, MaxSeries: 2}).process("sbc1", "default", zone)
+
+	expected := `
+# HELP sonus_sip_connection_bytes_received_total Bytes received on the SIP signaling connections from the peer.
+# TYPE sonus_sip_connection_bytes_received_total counter
+sonus_sip_connection_bytes_received_total{addresscontext="default",connection_id="2",peer_address="10.0.0.1",peer_port="5062",role="client",system="sbc1",transport="tls",zone="ZONE_A"} 25
+sonus_sip_connection_bytes_received_total{addresscontext="default",connection_id="3",peer_address="10.0.0.2",peer_port="5060",role="server",system="sbc1",transport="tcp",zone="ZONE_A"} 5000
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_sip_connection_bytes_received_total"); err != nil {
+		t.Error(err)
+	}
+}
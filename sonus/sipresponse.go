@@ -0,0 +1,75 @@
+package sonus
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// sipResponseLabels are the labels of the SIP response metrics.  Either
+// trunkgroup or peer is set, and method is only set for the per-method tables.
+var (
+	sipResponseLabels         = []string{"system", "addresscontext", "zone", "trunkgroup", "peer", "method", "direction", "code"}
+	sipIntervalResponseLabels = []string{"system", "addresscontext", "zone", "trunkgroup", "peer", "method", "direction", "code", "interval"}
+)
+
+// sipResponseMetrics are the SIP response code statistics of the trunk groups
+// and IP peers of the zones
+type sipResponseMetrics struct {
+	responses         *MetricVec
+	intervalResponses *MetricVec
+}
+
+func newSIPResponseMetrics(registry *prometheus.Registry) *sipResponseMetrics {
+	m := &sipResponseMetrics{
+		responses: NewMetricVec(
+			prometheus.BuildFQName("sonus", "sip", "response_total"),
+			"Number of SIP responses of the trunk group or IP peer, by direction and response code.",
+			prometheus.CounterValue, sipResponseLabels),
+		intervalResponses: NewMetricVec(
+			prometheus.BuildFQName("sonus", "sip", "interval_responses"),
+			"Number of SIP responses of the trunk group or IP peer within the interval, by direction and response code.",
+			prometheus.GaugeValue, sipIntervalResponseLabels),
+	}
+	registry.MustRegister(m.responses, m.intervalResponses)
+	return m
+}
+
+// process sets the SIP response metrics from the response tables of the zone
+func (m *sipResponseMetrics) process(system, context string, zone *Zone) {
+	for _, s := range zone.SipTrunkGroupResponseCurrentStatistics {
+		m.responses.Set(s.ResponseCount, system, context, zone.Name, s.Name, "", "", s.Direction, s.ResponseCode)
+	}
+	for _, s := range zone.SipIpPeerResponseCurrentStatistics {
+		m.responses.Set(s.ResponseCount, system, context, zone.Name, "", s.Name, "", s.Direction, s.ResponseCode)
+	}
+	for _, s := range zone.SipTrunkGroupResponseIntervalStatistics {
+		if valid, _ := strconv.ParseBool(s.IntervalValid); valid {
+			m.intervalResponses.Set(s.ResponseCount, system, context, zone.Name, s.Name, "", "", s.Direction, s.ResponseCode, s.Number)
+		}
+	}
+	for _, s := range zone.SipIpPeerResponseIntervalStatistics {
+		if valid, _ := strconv.ParseBool(s.IntervalValid); valid {
+			m.intervalResponses.Set(s.ResponseCount, system, context, zone.Name, "", s.Name, "", s.Direction, s.ResponseCode, s.Number)
+		}
+	}
+
+	methods := map[string][]SipMethodResponseStatistics{
+		"INVITE":   zone.SipInviteResponseCurrentStatistics,
+		"REGISTER": zone.SipRegisterResponseCurrentStatistics,
+		"BYE":      zone.SipByeResponseCurrentStatistics,
+		"OPTIONS":  zone.SipOptionResponseCurrentStatistics,
+	}
+	for method, stats := range methods {
+		for _, s := range stats {
+			for code, count := range map[string]float64{
+				"401": s.Response401,
+				"403": s.Response403,
+				"407": s.Response407,
+				"481": s.Response481,
+			} {
+				m.responses.Set(count, system, context, zone.Name, s.Name, "", method, "", code)
+			}
+		}
+	}
+}
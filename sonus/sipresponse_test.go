@@ -0,0 +1,80 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestSIPResponseMetrics(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <sipTrunkGroupResponseCurrentStatistics>
+    <name>TG1</name>
+    <direction>received</direction>
+    <responseCode>503</responseCode>
+    <responseCount>12</responseCount>
+  </sipTrunkGroupResponseCurrentStatistics>
+  <sipTrunkGroupResponseCurrentStatistics>
+    <name>TG1</name>
+    <direction>sent</direction>
+    <responseCode>486</responseCode>
+    <responseCount>4</responseCount>
+  </sipTrunkGroupResponseCurrentStatistics>
+  <sipIpPeerResponseCurrentStatistics>
+    <name>PEER1</name>
+    <direction>received</direction>
+    <responseCode>408</responseCode>
+    <responseCount>2</responseCount>
+  </sipIpPeerResponseCurrentStatistics>
+  <sipTrunkGroupResponseIntervalStatistics>
+    <number>1</number>
+    <name>TG1</name>
+    <direction>received</direction>
+    <responseCode>503</responseCode>
+    <intervalValid>true</intervalValid>
+    <responseCount>3</responseCount>
+  </sipTrunkGroupResponseIntervalStatistics>
+  <sipTrunkGroupResponseIntervalStatistics>
+    <number>2</number>
+    <name>TG1</name>
+    <direction>received</direction>
+    <responseCode>503</responseCode>
+    <intervalValid>false</intervalValid>
+    <responseCount>1</responseCount>
+  </sipTrunkGroupResponseIntervalStatistics>
+  <sipInviteResponseCurrentStatistics>
+    <name>TG1</name>
+    <response401>0</response401>
+    <response403>7</response403>
+    <response407>1</response407>
+    <response481>0</response481>
+  </sipInviteResponseCurrentStatistics>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	newSIPResponseMetrics(registry).process("sbc1", "default", zone)
+
+	expected := `
+# HELP sonus_sip_interval_responses Number of SIP responses of the trunk group or IP peer within the interval, by direction and response code.
+# TYPE sonus_sip_interval_responses gauge
+sonus_sip_interval_responses{addresscontext="default",code="503",direction="received",interval="1",method="",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 3
+# HELP sonus_sip_response_total Number of SIP responses of the trunk group or IP peer, by direction and response code.
+# TYPE sonus_sip_response_total counter
+sonus_sip_response_total{addresscontext="default",code="401",direction="",method="INVITE",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_sip_response_total{addresscontext="default",code="403",direction="",method="INVITE",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 7
+sonus_sip_response_total{addresscontext="default",code="407",direction="",method="INVITE",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1
+sonus_sip_response_total{addresscontext="default",code="408",direction="received",method="",peer="PEER1",system="sbc1",trunkgroup="",zone="ZONE_A"} 2
+sonus_sip_response_total{addresscontext="default",code="481",direction="",method="INVITE",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_sip_response_total{addresscontext="default",code="486",direction="sent",method="",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 4
+sonus_sip_response_total{addresscontext="default",code="503",direction="received",method="",peer="",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 12
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
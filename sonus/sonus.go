@@ -2,8 +2,6 @@ package sonus
 
 import (
 	"context"
-	"crypto/tls"
-	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
@@ -11,9 +9,12 @@ import (
 	"math/rand"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
+	pconfig "github.com/prometheus/common/config"
 	log "github.com/ringsq/go-logger"
+	"github.com/ringsq/sonus_exporter/config"
 )
 
 // Sonus URLs
@@ -23,14 +24,17 @@ const (
 	serverInfoPath       = "/sonusSystem:system/serverStatus"
 	contextListPath      = "/sonusAddressContext:addressContext"
 	zoneStatusPath       = "/sonusAddressContext:addressContext=%s/sonusZone:zone"
-	ipInterfaceGroupPath = "/operational/addressContext/%s/ipInterfaceGroup/"
+	ipInterfaceGroupPath = "/sonusAddressContext:addressContext=%s/sonusIpInterface:ipInterfaceGroup"
 	sipStatsPath         = "/operational/addressContext/%s/zone/%s/sipCurrentStatistics/"
 	fanStatusPath        = "/sonusSystem:system/fanStatus/"
 	powerSupplyPath      = "/sonusSystem:system/powerSupplyStatus/"
 	dspStatusPath        = "/sonusSystem:system/sonusDrmDspStatus:dspStatus" // possibly with /dspUsage appended
-	tgStatusPath         = "/operational/global/globalTrunkGroupStatus/"
+	tgStatusPath         = "/sonusGlobal:global/globalTrunkGroupStatus"
 	tgConfigPath         = "/config/addressContext/%s/zone/%s/sipTrunkGroup/"
 	callStatusPath       = "/operational/addressContext/%s/zone/%s/callCurrentStatistics/"
+	trafficControlPath   = "/sonusAddressContext:addressContext=%s/sonusZone:zone?fields=name;trafficControlCurrentStatistics;trafficControlIntervalStatistics"
+	alarmStatusPath      = "/sonusAlarms:alarms/currentStatus"
+	congestionStatusPath = "/sonusSystem:system/congestionStatus"
 )
 
 type system struct {
@@ -66,46 +70,97 @@ type SBC struct {
 	user            string
 	password        string
 	client          *http.Client
+	decoder         Decoder
+	retry           config.Retry
+	maxBodySize     int64
 	System          string
 	AddressContexts *AddressContexts
 }
 
-// NewSBC instantiates an SBC from the provided credentials
-func NewSBC(address, user, password string) *SBC {
+// NewClient creates the HTTP client used to call SBCs with the module settings.
+// The client keeps connections alive and can be shared between SBCs.
+func NewClient(module config.Module) (*http.Client, error) {
+	tlsConfig, err := pconfig.NewTLSConfig(&module.TLSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating TLS configuration: %w", err)
+	}
+	tr := &http.Transport{
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: 8,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	return &http.Client{Transport: tr}, nil
+}
+
+// NewSBC instantiates an SBC from the provided credentials and module settings
+func NewSBC(ctx context.Context, address, user, password string, module config.Module) (*SBC, error) {
+	client, err := NewClient(module)
+	if err != nil {
+		return nil, err
+	}
+	return NewSBCWithClient(ctx, address, user, password, client, module)
+}
+
+// NewSBCWithClient instantiates an SBC from the provided credentials using an
+// existing HTTP client.  The system name and address contexts are read from the SBC.
+func NewSBCWithClient(ctx context.Context, address, user, password string, client *http.Client, module config.Module) (*SBC, error) {
+	decoder, err := NewDecoder(module.Format)
+	if err != nil {
+		return nil, err
+	}
 	ac := &AddressContexts{}
 	sbc := &SBC{
 		target:          address,
 		user:            user,
 		password:        password,
+		client:          client,
+		decoder:         decoder,
+		retry:           module.Retry,
+		maxBodySize:     int64(module.MaxBodySize),
 		AddressContexts: ac,
 	}
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	sbc.client = &http.Client{Transport: tr}
 	sys := &system{}
-	ctx := context.Background()
 
-	err := sbc.GetAndParse(ctx, sys, systemInfoPath)
+	err = sbc.GetAndParse(ctx, sys, systemInfoPath)
 	if err != nil {
 		log.Errorf("Error calling SBC (%s): %v", systemInfoPath, err)
-		return nil
+		return nil, err
 	}
 	sbc.System = sys.Admin.Name
+	sbc.AddressContexts = sbc.discoverAddressContexts(ctx, module.AddressContexts)
+	return sbc, nil
+}
 
-	defaultContext := AddressContext{
-		Name: "default",
+// discoverAddressContexts reads the address contexts from the SBC and keeps the
+// ones selected by the configuration.  The static address contexts are returned
+// when discovery is disabled or fails, e.g. because the account is not permitted
+// to read the address context list.
+func (s *SBC) discoverAddressContexts(ctx context.Context, cfg config.AddressContexts) *AddressContexts {
+	static := &AddressContexts{}
+	for _, name := range cfg.Static {
+		static.AddressContext = append(static.AddressContext, AddressContext{Name: name})
+	}
+	if !cfg.Discovery {
+		return static
 	}
 
-	// Get the address contexts
-	// err = sbc.GetAndParse(ctx, ac, contextListPath)
-	// if err != nil {
-	// 	log.Errorf("Error getting context list: %v", err)
-	// 	return nil
-	// }
-	ac.AddressContext = append(ac.AddressContext, defaultContext)
-	sbc.AddressContexts = ac
-	return sbc
+	discovered := &AddressContexts{}
+	err := s.GetAndParse(ctx, discovered, contextListPath)
+	if err != nil {
+		log.Warnf("Address context discovery failed for %s, using %v: %v", s.target, cfg.Static, err)
+		return static
+	}
+	if len(discovered.AddressContext) == 0 {
+		log.Warnf("No address contexts discovered for %s, using %v", s.target, cfg.Static)
+		return static
+	}
+	ac := &AddressContexts{}
+	for _, aCtx := range discovered.AddressContext {
+		if cfg.Match(aCtx.Name) {
+			ac.AddressContext = append(ac.AddressContext, aCtx)
+		}
+	}
+	return ac
 }
 
 // buildURL takes the given path, adds the base to the beginning, and applies any
@@ -115,106 +170,201 @@ func (s *SBC) buildURL(path string, args ...any) string {
 	return fmt.Sprintf(url, args...)
 }
 
-// GetAndParse builds the URL, does a GET against the SBC, and parses the XML response.
-// Any errors are returned in error.
+// GetAndParse builds the URL, does a GET against the SBC, and parses the response with
+// the decoder of the module format.  Any errors are returned in error.
 func (s *SBC) GetAndParse(ctx context.Context, response any, path string, args ...any) error {
 	url := s.buildURL(path, args...)
-	resp, err := s.callSBC(ctx, http.MethodGet, url, nil)
+	resp, err := s.callSBC(ctx, http.MethodGet, path, url, nil)
 	if err != nil {
 		log.Errorf("Error calling SBC (%s): %v", url, err)
 		return err
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body := s.newBodyReader(resp.Body)
+	data, err := io.ReadAll(body)
+	sbcResponseSize.WithLabelValues(s.target, endpointName(path)).Observe(float64(body.n))
 	if err != nil {
-		log.Errorf("Error reading response body: %v", err)
+		log.Errorf("Error reading response body of %s: %v", url, err)
 		return err
 	}
-	err = xml.Unmarshal(body, response)
+	err = s.decoder.Decode(data, response)
+	if err != nil {
+		log.Errorf("Failed to deserialize %s into %v: %v; response begins with: %s", url, reflect.TypeOf(response), err, body.excerpt())
+		return &DecodeError{URLPath: resp.Request.URL.Path, Err: err}
+	}
+	return nil
+}
+
+// StreamAndParse builds the URL, does a GET against the SBC, and calls fn for
+// every element of the response with the local name as it is parsed, so large
+// responses are not held in memory.  fn parses the element with decode.
+func (s *SBC) StreamAndParse(ctx context.Context, name string, fn func(decode func(v any) error) error, path string, args ...any) error {
+	url := s.buildURL(path, args...)
+	resp, err := s.callSBC(ctx, http.MethodGet, path, url, nil)
 	if err != nil {
-		log.Errorf("BODY: %v", body)
-		log.Errorf("Failed to deserialize %s into %v: %v", url, reflect.TypeOf(response), err)
+		log.Errorf("Error calling SBC (%s): %v", url, err)
 		return err
 	}
+	defer resp.Body.Close()
+
+	body := s.newBodyReader(resp.Body)
+	err = s.decoder.Stream(body, name, fn)
+	sbcResponseSize.WithLabelValues(s.target, endpointName(path)).Observe(float64(body.n))
+	if err != nil {
+		if errors.Is(err, ErrBodyTooLarge) {
+			log.Errorf("Error reading response body of %s: %v", url, err)
+			return err
+		}
+		log.Errorf("Failed to deserialize %s %s elements at byte %d: %v; response begins with: %s", url, name, body.n, err, body.excerpt())
+		return &DecodeError{URLPath: resp.Request.URL.Path, Err: err}
+	}
 	return nil
 }
 
-// callSBC is responsible for building the request object, sending it to the SBC, and checking the response status.
-func (s *SBC) callSBC(ctx context.Context, method string, url string, body io.Reader) (*http.Response, error) {
+// excerptSize is the number of bytes of a response logged when it cannot be parsed
+const excerptSize = 512
 
-	// startTime := time.Now()
-	time.Sleep(time.Duration(rand.Intn(500)) * time.Millisecond)
+// bodyReader counts the bytes read from a response body, keeps the beginning
+// of the response for error messages, and fails once more than limit bytes are read.
+type bodyReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+	head  []byte
+}
+
+// newBodyReader wraps the response body with the maximum body size of the SBC.
+func (s *SBC) newBodyReader(r io.Reader) *bodyReader {
+	return &bodyReader{r: r, limit: s.maxBodySize}
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if rest := excerptSize - len(b.head); rest > 0 {
+		if rest > n {
+			rest = n
+		}
+		b.head = append(b.head, p[:rest]...)
+	}
+	b.n += int64(n)
+	if b.limit > 0 && b.n > b.limit {
+		return n, ErrBodyTooLarge
+	}
+	return n, err
+}
+
+// excerpt returns the beginning of the response read so far.
+func (b *bodyReader) excerpt() string {
+	if b.n > int64(len(b.head)) {
+		return fmt.Sprintf("%s... (%d bytes)", b.head, b.n)
+	}
+	return string(b.head)
+}
+
+// callSBC is responsible for building the request object, sending it to the SBC, and checking the response status.
+// Responses with a retryable status code are retried according to the retry policy of the SBC.  The path is
+// the unformatted path of the URL, used to label the request metrics.
+func (s *SBC) callSBC(ctx context.Context, method string, path string, url string, body io.Reader) (*http.Response, error) {
+	endpoint := endpointName(path)
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		log.Errorf("Error creating request to %s %s: %v", method, url, err)
 		return nil, err
 	}
 	req.SetBasicAuth(s.user, s.password)
-	req.Header.Add("Accept", "application/vnd.yang.collection+xml")
+	req.Header.Add("Accept", s.decoder.Accept())
 	var resp *http.Response
-	for retries := 3; retries > 0; retries-- {
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
 		resp, err = s.client.Do(req)
+		sbcRequestDuration.WithLabelValues(s.target, endpoint).Observe(time.Since(start).Seconds())
 		if err != nil {
+			sbcRequests.WithLabelValues(s.target, endpoint, "error").Inc()
 			log.Errorf("Error with SBC call %s %s: %v", method, url, err)
 			return nil, err
 		}
-
-		if prob := checkResponse(resp); prob != nil {
-			log.Errorf("Error response from %s %s: %v", method, url, prob)
-			return nil, prob
+		sbcRequests.WithLabelValues(s.target, endpoint, statusClass(resp.StatusCode)).Inc()
+		if attempt >= s.retry.MaxAttempts || !s.retry.Retryable(resp.StatusCode) {
+			break
 		}
-		if resp.StatusCode == 204 {
-			sleepTime := rand.Intn(1000)
-			log.Warnf("%s received for %s, retry %d in %dms...", resp.Status, url, 3-retries+1, sleepTime)
-			time.Sleep(time.Duration(sleepTime) * time.Millisecond)
-			continue
+		resp.Body.Close()
+
+		delay := backoff(s.retry, attempt)
+		log.Warnf("%s received for %s, retry %d in %s...", resp.Status, url, attempt, delay)
+		sbcRetries.WithLabelValues(s.target, endpoint).Inc()
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
-		break
+	}
+
+	if prob := checkResponse(resp); prob != nil {
+		log.Errorf("Error response from %s %s: %v", method, url, prob)
+		return nil, prob
 	}
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Invalid response received: %s", resp.Status)
+		resp.Body.Close()
+		return nil, newRestconfError(resp, nil)
 	}
 	return resp, nil
 }
 
+// backoff returns the delay before the next attempt.  The initial backoff is doubled
+// for every previous attempt and capped at the maximum backoff, and a random
+// duration between half and all of that is returned.  The cap is checked before
+// shifting, so that the doubled backoff cannot overflow.
+func backoff(retry config.Retry, attempt int) time.Duration {
+	ceiling := retry.MaxBackoff
+	if shift := attempt - 1; retry.InitialBackoff < retry.MaxBackoff>>shift {
+		ceiling = retry.InitialBackoff << shift
+	}
+	return ceiling/2 + time.Duration(rand.Int63n(int64(ceiling/2)+1))
+}
+
 // checkResponse inspects the response status codes and creates an appropriate problem.  If
-// no issues are found `nil` is returned
+// no issues are found `nil` is returned.  Problems are returned as a *RestconfError.
 func checkResponse(resp *http.Response) error {
 	status := resp.StatusCode
 	if status < 300 {
 		return nil
 	}
 	if status < 400 {
-		return fmt.Errorf("Redirect received from Sonus: %v", resp.Status)
+		resp.Body.Close()
+		return newRestconfError(resp, nil)
 	}
 	if status < 500 {
-		prob := errors.New(getError(resp))
+		prob := newRestconfError(resp, getError(resp))
 		log.Warn(prob)
 		return prob
 	}
 	if status < 600 {
-		prob := errors.New(getError(resp))
+		prob := newRestconfError(resp, getError(resp))
 		log.Error(prob)
 		return prob
 	}
 	return nil
 }
 
-// getError reads the error message from the Sonus response
-func getError(resp *http.Response) string {
+// getError reads the error message from the Sonus response.  nil is returned when
+// the response does not contain an errors document.
+func getError(resp *http.Response) *Errors {
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return resp.Status
+	if err != nil || len(body) == 0 {
+		return nil
 	}
-	if len(body) == 0 {
-		return resp.Status
+	var decoder Decoder = xmlDecoder{}
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		decoder = jsonDecoder{}
 	}
-	sbcErr := Errors{}
-	err = xml.Unmarshal(body, &sbcErr)
+	sbcErr := &Errors{}
+	err = decoder.Decode(body, sbcErr)
 	if err != nil {
-		return resp.Status
+		return nil
 	}
-	return sbcErr.Error()
+	return sbcErr
 }
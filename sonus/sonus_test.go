@@ -2,17 +2,38 @@ package sonus
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
 )
 
 var testSBC *SBC
 
 func init() {
-	testSBC = NewSBC(os.Getenv("SONUS_TARGET"), os.Getenv("SONUS_USER"), os.Getenv("SONUS_PASSWORD"))
+	if os.Getenv("SONUS_TARGET") == "" {
+		return
+	}
+	testSBC, _ = NewSBC(context.Background(), os.Getenv("SONUS_TARGET"), os.Getenv("SONUS_USER"), os.Getenv("SONUS_PASSWORD"), config.DefaultModule)
+}
+
+// requireSBC skips tests that need a live SBC when SONUS_TARGET is not set
+func requireSBC(t *testing.T) {
+	t.Helper()
+	if os.Getenv("SONUS_TARGET") == "" {
+		t.Skip("SONUS_TARGET not set, skipping test against a live SBC")
+	}
 }
 
 func TestNewSBC(t *testing.T) {
+	requireSBC(t)
 	type args struct {
 		address  string
 		user     string
@@ -33,9 +54,9 @@ func TestNewSBC(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sbc := NewSBC(tt.args.address, tt.args.user, tt.args.password)
-			if sbc == nil {
-				t.Errorf("NewSBC() = %v", sbc)
+			sbc, err := NewSBC(context.Background(), tt.args.address, tt.args.user, tt.args.password, config.DefaultModule)
+			if err != nil {
+				t.Fatalf("NewSBC() error = %v", err)
 			}
 			if sbc.AddressContexts == nil || len(sbc.AddressContexts.AddressContext) == 0 {
 				t.Errorf("NewSBC() no contexts found: %v", sbc.AddressContexts)
@@ -45,6 +66,7 @@ func TestNewSBC(t *testing.T) {
 }
 
 func TestZoneStatus(t *testing.T) {
+	requireSBC(t)
 	for _, aCtx := range testSBC.AddressContexts.AddressContext {
 		stats := &ZoneStats{}
 		err := testSBC.GetAndParse(context.Background(), stats, zoneStatusPath, aCtx.Name)
@@ -58,3 +80,211 @@ func TestZoneStatus(t *testing.T) {
 		// }
 	}
 }
+
+const testAddressContexts = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <addressContext xmlns="http://sonusnet.com/ns/mibs/SONUS-ADDRESS-CONTEXT/1.0"><name>default</name></addressContext>
+  <addressContext xmlns="http://sonusnet.com/ns/mibs/SONUS-ADDRESS-CONTEXT/1.0"><name>core</name></addressContext>
+  <addressContext xmlns="http://sonusnet.com/ns/mibs/SONUS-ADDRESS-CONTEXT/1.0"><name>test_lab</name></addressContext>
+</collection>`
+
+func TestDiscoverAddressContexts(t *testing.T) {
+	discoveryStatus := http.StatusOK
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, contextListPath):
+			w.WriteHeader(discoveryStatus)
+			if discoveryStatus == http.StatusOK {
+				w.Write([]byte(testAddressContexts))
+			}
+		default:
+			w.Write([]byte(testSystemAdmin))
+		}
+	}))
+	defer srv.Close()
+	target := strings.TrimPrefix(srv.URL, "https://")
+
+	tests := []struct {
+		name   string
+		status int
+		cfg    config.AddressContexts
+		want   []string
+	}{
+		{
+			name:   "all discovered",
+			status: http.StatusOK,
+			cfg:    config.DefaultAddressContexts,
+			want:   []string{"default", "core", "test_lab"},
+		},
+		{
+			name:   "filtered",
+			status: http.StatusOK,
+			cfg: config.AddressContexts{
+				Discovery: true,
+				Include:   []config.Regexp{config.MustNewRegexp("default|core|test.*")},
+				Exclude:   []config.Regexp{config.MustNewRegexp("test.*")},
+			},
+			want: []string{"default", "core"},
+		},
+		{
+			name:   "discovery not permitted",
+			status: http.StatusForbidden,
+			cfg:    config.AddressContexts{Discovery: true, Static: []string{"default", "core"}},
+			want:   []string{"default", "core"},
+		},
+		{
+			name:   "discovery disabled",
+			status: http.StatusOK,
+			cfg:    config.AddressContexts{Static: []string{"core"}},
+			want:   []string{"core"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discoveryStatus = tt.status
+			module := config.DefaultModule
+			module.AddressContexts = tt.cfg
+			sbc, err := NewSBC(context.Background(), target, "user", "password", module)
+			if err != nil {
+				t.Fatalf("NewSBC() error = %v", err)
+			}
+			var got []string
+			for _, aCtx := range sbc.AddressContexts.AddressContext {
+				got = append(got, aCtx.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSBC() address contexts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCallSBCRetry(t *testing.T) {
+	var calls int
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(testSystemAdmin))
+	}))
+	defer srv.Close()
+	target := strings.TrimPrefix(srv.URL, "https://")
+
+	module := config.DefaultModule
+	module.AddressContexts = config.AddressContexts{Static: []string{"default"}}
+	module.Retry = config.Retry{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, StatusCodes: []int{204}}
+	if _, err := NewSBC(context.Background(), target, "user", "password", module); err != nil {
+		t.Fatalf("NewSBC() error = %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("SBC called %d times, want 3", calls)
+	}
+
+	calls = 0
+	module.Retry.MaxAttempts = 2
+	if _, err := NewSBC(context.Background(), target, "user", "password", module); err == nil {
+		t.Errorf("NewSBC() succeeded after exhausting the retries")
+	}
+
+	// A cancelled context must interrupt the backoff.
+	calls = 0
+	module.Retry = config.Retry{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour, StatusCodes: []int{204}}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := NewSBC(ctx, target, "user", "password", module); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("NewSBC() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("NewSBC() ignored the context cancellation")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	retry := config.Retry{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, ceiling := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 4: 800 * time.Millisecond, 5: time.Second, 40: time.Second} {
+		for i := 0; i < 20; i++ {
+			if d := backoff(retry, attempt); d < ceiling/2 || d > ceiling {
+				t.Errorf("backoff(%d) = %s, want between %s and %s", attempt, d, ceiling/2, ceiling)
+			}
+		}
+	}
+
+	// Doubling a second 30 times overflows a time.Duration
+	retry = config.Retry{InitialBackoff: time.Second, MaxBackoff: time.Minute}
+	for attempt := 1; attempt <= 70; attempt++ {
+		if d := backoff(retry, attempt); d <= 0 || d > time.Minute {
+			t.Errorf("backoff(%d) = %s, want between 0 and %s", attempt, d, time.Minute)
+		}
+	}
+}
+
+// newTestSBC returns an SBC for a TLS server that answers the requests for the
+// paths in responses, relative to /restconf/data and including the query, with
+// the given bodies.  The system information is always answered, and any other
+// path returns 404, so a collector requesting the wrong path fails.  Address
+// context discovery is disabled, so the SBC only has the default context.
+func newTestSBC(t *testing.T, responses map[string]string) (*SBC, config.Module) {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/restconf/data")
+		if r.URL.RawQuery != "" {
+			path += "?" + r.URL.RawQuery
+		}
+		if path == systemInfoPath {
+			w.Write([]byte(testSystemAdmin))
+			return
+		}
+		body, ok := responses[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	module := config.DefaultModule
+	module.AddressContexts.Discovery = false
+	sbc, err := NewSBC(context.Background(), strings.TrimPrefix(srv.URL, "https://"), "user", "password", module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sbc, module
+}
+
+// gatherValue returns the value of the series of the metric family name that
+// has the given labels, ignoring its other labels, and whether it exists.
+func gatherValue(t *testing.T, g prometheus.Gatherer, name string, labels map[string]string) (float64, bool) {
+	t.Helper()
+	families, err := g.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, m := range mf.GetMetric() {
+			matched := 0
+			for _, lp := range m.GetLabel() {
+				if value, ok := labels[lp.GetName()]; ok {
+					if value != lp.GetValue() {
+						continue metrics
+					}
+					matched++
+				}
+			}
+			if matched != len(labels) {
+				continue
+			}
+			if m.GetCounter() != nil {
+				return m.GetCounter().GetValue(), true
+			}
+			return m.GetGauge().GetValue(), true
+		}
+	}
+	return 0, false
+}
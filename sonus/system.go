@@ -31,7 +31,11 @@ type ServerInfo struct {
 	} `xml:"serverStatus"`
 }
 
-func ServerInfoMetrics(ctx context.Context, sbc *SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error {
+func init() {
+	RegisterCollector("system", ServerInfoMetrics)
+}
+
+func ServerInfoMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
 	var (
 		serverInfoVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "sonus_info",
@@ -0,0 +1,163 @@
+package sonus
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+	"golang.org/x/sync/errgroup"
+)
+
+/*
+<collection xmlns:y="http://tail-f.com/ns/rest">
+  <congestionStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-SYSTEM-MIB/1.0">
+    <mcLevel>MC0</mcLevel>
+  </congestionStatus>
+</collection>
+*/
+
+// congestionStatusCollection is the congestionStatus of the SONUS-SYSTEM-MIB
+// module.  Older SBC releases do not have it.
+type congestionStatusCollection struct {
+	CongestionStatus []congestionStatus `xml:"congestionStatus"`
+}
+
+type congestionStatus struct {
+	MCLevel string `xml:"mcLevel"`
+}
+
+// congestionLevels are the machine congestion levels of the SBC.  From MC1 on
+// the SBC gaps and rejects new calls to shed load.
+var congestionLevels = []string{"MC0", "MC1", "MC2", "MC3"}
+
+// trafficControlZone holds the traffic control tables of a zone, the only
+// fields requested from the zone status.
+type trafficControlZone struct {
+	Name     string                             `xml:"name"`
+	Current  []TrafficControlStatistics         `xml:"trafficControlCurrentStatistics"`
+	Interval []TrafficControlIntervalStatistics `xml:"trafficControlIntervalStatistics"`
+}
+
+// trafficControls maps the traffic control counters of the SBC to the metric
+// family and the control label value.  The SILC (selective incoming load
+// control), CANT (cancel to), CANF (cancel from), and the STR (selective trunk
+// reservation) and ACC (automatic congestion control) cancel controls block
+// calls, the SKIP controls skip the trunk group when routing.  The reroute
+// counters are the immediate (IRR), overflow (ORR) and the special (SIRR,
+// SORR) reroutes.
+var trafficControls = []struct {
+	field   string
+	family  string
+	control string
+}{
+	{"Silc", "blocked_calls", "silc"},
+	{"Cant", "blocked_calls", "cant"},
+	{"Canf", "blocked_calls", "canf"},
+	{"StrCant", "blocked_calls", "str_cant"},
+	{"AccCant", "blocked_calls", "acc_cant"},
+	{"Skip", "skipped_calls", "skip"},
+	{"StrSkip", "skipped_calls", "str_skip"},
+	{"AccSkip", "skipped_calls", "acc_skip"},
+	{"RouteAttemptsIRR", "reroute_attempts", "irr"},
+	{"RouteAttemptsSIRR", "reroute_attempts", "sirr"},
+	{"RouteAttemptsORR", "reroute_attempts", "orr"},
+	{"RouteAttemptsSORR", "reroute_attempts", "sorr"},
+	{"SuccessfulIRR", "reroute_successes", "irr"},
+	{"SuccessfulSIRR", "reroute_successes", "sirr"},
+	{"SuccessfulORR", "reroute_successes", "orr"},
+	{"SuccessfulSORR", "reroute_successes", "sorr"},
+}
+
+// trafficControlHelp is the help text of the traffic control metric families
+var trafficControlHelp = map[string]string{
+	"blocked_calls":     "Number of calls of the trunk group blocked by the traffic control, by control.",
+	"skipped_calls":     "Number of calls that skipped the trunk group due to the traffic control, by control.",
+	"reroute_attempts":  "Number of reroute attempts of the trunk group, by reroute control.",
+	"reroute_successes": "Number of successful reroutes of the trunk group, by reroute control.",
+}
+
+func init() {
+	RegisterOptionalCollector("trafficcontrol", TrafficControlMetrics)
+}
+
+// TrafficControlMetrics reports the traffic control statistics of the trunk
+// groups of every address context, and the congestion level of the SBC.  Only
+// the traffic control tables of the zones are requested, but as the zones
+// collector fetches the same zones the collector is optional.
+func TrafficControlMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+	labels := []string{"system", "addresscontext", "zone", "trunkgroup", "control"}
+	current := map[string]*MetricVec{}
+	interval := map[string]*MetricVec{}
+	for family, help := range trafficControlHelp {
+		current[family] = NewMetricVec(prometheus.BuildFQName("sonus", "traffic_control", family+"_total"),
+			help, prometheus.CounterValue, labels)
+		interval[family] = NewMetricVec(prometheus.BuildFQName("sonus", "traffic_control", "interval_"+family),
+			help, prometheus.GaugeValue, append(labels[:len(labels):len(labels)], "interval"))
+		registry.MustRegister(current[family], interval[family])
+	}
+	congestion := NewMetricVec("sonus_system_congestion_level",
+		"Machine congestion level of the SBC, 1 for the current level.", prometheus.GaugeValue, []string{"system", "state"})
+	overloaded := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sonus_system_overloaded",
+		Help: "1 when the SBC is congested and gapping or rejecting calls.",
+	}, []string{"system"})
+	registry.MustRegister(congestion, overloaded)
+
+	g := &errgroup.Group{}
+	for _, aCtx := range sbc.AddressContexts.AddressContext {
+		aCtx := aCtx
+		g.Go(func() error {
+			return sbc.StreamAndParse(ctx, "zone", func(decode func(v any) error) error {
+				zone := &trafficControlZone{}
+				if err := decode(zone); err != nil {
+					return err
+				}
+				for _, s := range zone.Current {
+					setTrafficControls(current, reflect.ValueOf(s), sbc.System, aCtx.Name, zone.Name, s.Name)
+				}
+				for _, s := range zone.Interval {
+					if valid, _ := strconv.ParseBool(s.IntervalValid); valid {
+						setTrafficControls(interval, reflect.ValueOf(s), sbc.System, aCtx.Name, zone.Name, s.Name, s.Number)
+					}
+				}
+				return nil
+			}, trafficControlPath, aCtx.Name)
+		})
+	}
+	g.Go(func() error {
+		status := &congestionStatusCollection{}
+		err := sbc.GetAndParse(ctx, status, congestionStatusPath)
+		if ErrorType(err) == ErrorTypeNotFound {
+			level.Warn(logger).Log("msg", "Congestion status is not available on the SBC", "err", err)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, s := range status.CongestionStatus {
+			if !setStates(congestion, congestionLevels, s.MCLevel, sbc.System) {
+				level.Warn(logger).Log("msg", "Unknown congestion level", "level", s.MCLevel)
+			}
+			if s.MCLevel != "" && s.MCLevel != congestionLevels[0] {
+				overloaded.WithLabelValues(sbc.System).Set(1)
+			} else {
+				overloaded.WithLabelValues(sbc.System).Set(0)
+			}
+		}
+		return nil
+	})
+	return g.Wait()
+}
+
+// setTrafficControls sets the traffic control metrics from the counters of s.
+// The interval number, if any, follows the control label.
+func setTrafficControls(metrics map[string]*MetricVec, s reflect.Value, system, context, zone, trunkGroup string, interval ...string) {
+	for _, tc := range trafficControls {
+		labelValues := append([]string{system, context, zone, trunkGroup, tc.control}, interval...)
+		metrics[tc.family].Set(s.FieldByName(tc.field).Float(), labelValues...)
+	}
+}
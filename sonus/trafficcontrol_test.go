@@ -0,0 +1,125 @@
+package sonus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+const testTrafficControl = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+    <name>ZONE_A</name>
+    <trafficControlCurrentStatistics>
+      <name>TG1</name>
+      <silc>4</silc>
+      <cant>2</cant>
+      <skip>9</skip>
+      <routeAttemptsIRR>5</routeAttemptsIRR>
+      <successfulIRR>3</successfulIRR>
+    </trafficControlCurrentStatistics>
+    <trafficControlIntervalStatistics>
+      <number>1</number>
+      <name>TG1</name>
+      <intervalValid>true</intervalValid>
+      <silc>1</silc>
+    </trafficControlIntervalStatistics>
+    <trafficControlIntervalStatistics>
+      <number>2</number>
+      <name>TG1</name>
+      <intervalValid>false</intervalValid>
+      <silc>6</silc>
+    </trafficControlIntervalStatistics>
+  </zone>
+</collection>`
+
+const testCongestionStatus = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <congestionStatus xmlns="http://sonusnet.com/ns/mibs/SONUS-SYSTEM-MIB/1.0">
+    <mcLevel>MC2</mcLevel>
+  </congestionStatus>
+</collection>`
+
+func TestTrafficControlMetrics(t *testing.T) {
+	sbc, module := newTestSBC(t, map[string]string{
+		fmt.Sprintf(trafficControlPath, "default"): testTrafficControl,
+	})
+	registry := prometheus.NewRegistry()
+	if err := TrafficControlMetrics(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("TrafficControlMetrics() error = %v", err)
+	}
+
+	expected := `
+# HELP sonus_traffic_control_interval_blocked_calls Number of calls of the trunk group blocked by the traffic control, by control.
+# TYPE sonus_traffic_control_interval_blocked_calls gauge
+sonus_traffic_control_interval_blocked_calls{addresscontext="default",control="acc_cant",interval="1",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_traffic_control_interval_blocked_calls{addresscontext="default",control="canf",interval="1",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_traffic_control_interval_blocked_calls{addresscontext="default",control="cant",interval="1",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_traffic_control_interval_blocked_calls{addresscontext="default",control="silc",interval="1",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 1
+sonus_traffic_control_interval_blocked_calls{addresscontext="default",control="str_cant",interval="1",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+# HELP sonus_traffic_control_reroute_successes_total Number of successful reroutes of the trunk group, by reroute control.
+# TYPE sonus_traffic_control_reroute_successes_total counter
+sonus_traffic_control_reroute_successes_total{addresscontext="default",control="irr",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 3
+sonus_traffic_control_reroute_successes_total{addresscontext="default",control="orr",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_traffic_control_reroute_successes_total{addresscontext="default",control="sirr",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_traffic_control_reroute_successes_total{addresscontext="default",control="sorr",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+# HELP sonus_traffic_control_skipped_calls_total Number of calls that skipped the trunk group due to the traffic control, by control.
+# TYPE sonus_traffic_control_skipped_calls_total counter
+sonus_traffic_control_skipped_calls_total{addresscontext="default",control="acc_skip",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_traffic_control_skipped_calls_total{addresscontext="default",control="skip",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 9
+sonus_traffic_control_skipped_calls_total{addresscontext="default",control="str_skip",system="testsbc",trunkgroup="TG1",zone="ZONE_A"} 0
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_traffic_control_interval_blocked_calls", "sonus_traffic_control_reroute_successes_total",
+		"sonus_traffic_control_skipped_calls_total"); err != nil {
+		t.Error(err)
+	}
+
+}
+
+func TestTrafficControlCongestion(t *testing.T) {
+	sbc, module := newTestSBC(t, map[string]string{
+		fmt.Sprintf(trafficControlPath, "default"): testTrafficControl,
+		congestionStatusPath:                       testCongestionStatus,
+	})
+	registry := prometheus.NewRegistry()
+	if err := TrafficControlMetrics(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("TrafficControlMetrics() error = %v", err)
+	}
+	expected := `
+# HELP sonus_system_congestion_level Machine congestion level of the SBC, 1 for the current level.
+# TYPE sonus_system_congestion_level gauge
+sonus_system_congestion_level{state="MC0",system="testsbc"} 0
+sonus_system_congestion_level{state="MC1",system="testsbc"} 0
+sonus_system_congestion_level{state="MC2",system="testsbc"} 1
+sonus_system_congestion_level{state="MC3",system="testsbc"} 0
+sonus_system_congestion_level{state="unknown",system="testsbc"} 0
+# HELP sonus_system_overloaded 1 when the SBC is congested and gapping or rejecting calls.
+# TYPE sonus_system_overloaded gauge
+sonus_system_overloaded{system="testsbc"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_system_congestion_level", "sonus_system_overloaded"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTrafficControlWithoutCongestionStatus(t *testing.T) {
+	// The test SBC answers 404 for the congestion status
+	sbc, module := newTestSBC(t, map[string]string{
+		fmt.Sprintf(trafficControlPath, "default"): testTrafficControl,
+	})
+	registry := prometheus.NewRegistry()
+	if err := TrafficControlMetrics(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("TrafficControlMetrics() error = %v, want the missing congestion status to be ignored", err)
+	}
+	if n, err := testutil.GatherAndCount(registry, "sonus_system_overloaded"); err != nil || n != 0 {
+		t.Errorf("sonus_system_overloaded series = %d, want 0 (err = %v)", n, err)
+	}
+	if n, err := testutil.GatherAndCount(registry, "sonus_traffic_control_blocked_calls_total"); err != nil || n != 5 {
+		t.Errorf("sonus_traffic_control_blocked_calls_total series = %d, want 5 (err = %v)", n, err)
+	}
+}
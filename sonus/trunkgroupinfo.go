@@ -0,0 +1,48 @@
+package sonus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+// trunkGroupInfoValues returns the values of the optional sonus_trunkgroup_info labels
+var trunkGroupInfoValues = map[string]func(tg *SipTrunkGroup) string{
+	"carrier":                func(tg *SipTrunkGroup) string { return tg.Policy.Carrier },
+	"country":                func(tg *SipTrunkGroup) string { return tg.Policy.Country },
+	"ip_signaling_profile":   func(tg *SipTrunkGroup) string { return tg.Policy.Signaling.IpSignalingProfile },
+	"packet_service_profile": func(tg *SipTrunkGroup) string { return tg.Policy.Media.PacketServiceProfile },
+	"class_of_service":       func(tg *SipTrunkGroup) string { return tg.Policy.Services.ClassOfService },
+}
+
+// trunkGroupInfo is the sonus_trunkgroup_info metric.  It has the same
+// system, addresscontext, zone and trunkgroup labels as the trunk group
+// statistics, so the configuration labels can be joined onto them.
+type trunkGroupInfo struct {
+	labels []string
+	metric *prometheus.GaugeVec
+}
+
+func newTrunkGroupInfo(registry *prometheus.Registry, cfg config.TrunkGroupInfo) *trunkGroupInfo {
+	labels := cfg.Labels()
+	info := &trunkGroupInfo{
+		labels: labels,
+		metric: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: prometheus.BuildFQName("sonus", "trunkgroup", "info"),
+			Help: "Configuration of the SIP trunk group, always 1.",
+		}, append([]string{"system", "addresscontext", "zone", "trunkgroup"}, labels...)),
+	}
+	registry.MustRegister(info.metric)
+	return info
+}
+
+// process sets the info metric of the trunk groups of the zone
+func (i *trunkGroupInfo) process(system, context string, zone *Zone) {
+	for n := range zone.SipTrunkGroup {
+		tg := &zone.SipTrunkGroup[n]
+		labelValues := []string{system, context, zone.Name, tg.Name}
+		for _, label := range i.labels {
+			labelValues = append(labelValues, trunkGroupInfoValues[label](tg))
+		}
+		i.metric.WithLabelValues(labelValues...).Set(1)
+	}
+}
@@ -0,0 +1,59 @@
+package sonus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+func TestTrunkGroupInfo(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <sipTrunkGroup>
+    <name>TG1</name>
+    <policy>
+      <carrier>0042</carrier>
+      <country>1</country>
+      <media>
+        <packetServiceProfile>PSP_G711</packetServiceProfile>
+      </media>
+      <services>
+        <classOfService>COS_DEFAULT</classOfService>
+      </services>
+      <signaling>
+        <ipSignalingProfile>IPSP_CARRIER</ipSignalingProfile>
+      </signaling>
+    </policy>
+  </sipTrunkGroup>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := prometheus.NewRegistry()
+	newTrunkGroupInfo(registry, config.TrunkGroupInfo{}).process("sbc1", "default", zone)
+	expected := `
+# HELP sonus_trunkgroup_info Configuration of the SIP trunk group, always 1.
+# TYPE sonus_trunkgroup_info gauge
+sonus_trunkgroup_info{addresscontext="default",carrier="0042",class_of_service="COS_DEFAULT",country="1",ip_signaling_profile="IPSP_CARRIER",packet_service_profile="PSP_G711",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+
+	registry = prometheus.NewRegistry()
+	cfg := config.TrunkGroupInfo{DropLabels: []string{"packet_service_profile", "ip_signaling_profile"}}
+	newTrunkGroupInfo(registry, cfg).process("sbc1", "default", zone)
+	expected = `
+# HELP sonus_trunkgroup_info Configuration of the SIP trunk group, always 1.
+# TYPE sonus_trunkgroup_info gauge
+sonus_trunkgroup_info{addresscontext="default",carrier="0042",class_of_service="COS_DEFAULT",country="1",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,74 @@
+package sonus
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ringsq/sonus_exporter/config"
+)
+
+/*
+<collection xmlns:y="http://tail-f.com/ns/rest">
+  <globalTrunkGroupStatus>
+    <name>TG1</name>
+    <addressContext>default</addressContext>
+    <zone>ZONE_A</zone>
+    <state>inService</state>
+    <totalCallsAvailable>950</totalCallsAvailable>
+    <inboundCallsUsage>30</inboundCallsUsage>
+    ...
+  </globalTrunkGroupStatus>
+</collection>
+*/
+
+type globalTrunkGroupStatusCollection struct {
+	TrunkGroupStatus []TrunkGroupStatus `xml:"globalTrunkGroupStatus"`
+}
+
+// trunkGroupLabels are the labels of the trunkgroups collector metrics, the
+// same as those of the trunk group statistics of the zones collector
+var trunkGroupLabels = []string{"system", "addresscontext", "zone", "trunkgroup"}
+
+func init() {
+	RegisterOptionalCollector("trunkgroups", TrunkGroupMetrics)
+}
+
+// TrunkGroupMetrics reports the call and bandwidth usage and the state of
+// the trunk groups of the selected address contexts from the global trunk
+// group status.  It needs a single request, so it is a cheap alternative to
+// the zones collector when only the trunk group usage is of interest.
+func TrunkGroupMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
+	metrics := newFieldMetrics(registry, "sonus_trunkgroup", reflect.TypeOf(TrunkGroupStatus{}), trunkGroupLabels)
+	stateLabels := append(trunkGroupLabels[:len(trunkGroupLabels):len(trunkGroupLabels)], "state")
+	state := NewMetricVec("sonus_trunkgroup_state", "Service state of the trunk group, 1 for the current state.",
+		prometheus.GaugeValue, stateLabels)
+	packetOutage := NewMetricVec("sonus_trunkgroup_packet_outage_state", "Packet outage detection state of the trunk group, 1 for the current state.",
+		prometheus.GaugeValue, stateLabels)
+	registry.MustRegister(state, packetOutage)
+
+	status := &globalTrunkGroupStatusCollection{}
+	if err := sbc.GetAndParse(ctx, status, tgStatusPath); err != nil {
+		return err
+	}
+	selected := map[string]bool{}
+	for _, aCtx := range sbc.AddressContexts.AddressContext {
+		selected[aCtx.Name] = true
+	}
+	for _, tg := range status.TrunkGroupStatus {
+		if !selected[tg.AddressContext] {
+			continue
+		}
+		labelValues := []string{sbc.System, tg.AddressContext, tg.Zone, tg.Name}
+		metrics.set(reflect.ValueOf(tg), labelValues...)
+		if !setStates(state, metricStates["sonus_Zone_TrunkGroupStatus_State"], tg.State, labelValues...) {
+			level.Warn(logger).Log("msg", "Unknown trunk group state", "trunkgroup", tg.Name, "state", tg.State)
+		}
+		if !setStates(packetOutage, metricStates["sonus_Zone_TrunkGroupStatus_PacketOutDetectState"], tg.PacketOutDetectState, labelValues...) {
+			level.Warn(logger).Log("msg", "Unknown trunk group packet outage state", "trunkgroup", tg.Name, "state", tg.PacketOutDetectState)
+		}
+	}
+	return nil
+}
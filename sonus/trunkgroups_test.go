@@ -0,0 +1,63 @@
+package sonus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const testGlobalTrunkGroupStatus = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <globalTrunkGroupStatus>
+    <name>TG1</name>
+    <addressContext>default</addressContext>
+    <zone>ZONE_A</zone>
+    <state>inService</state>
+    <totalCallsAvailable>950</totalCallsAvailable>
+    <inboundCallsUsage>30</inboundCallsUsage>
+    <outboundCallsUsage>20</outboundCallsUsage>
+    <bwAvailable>64000</bwAvailable>
+    <packetOutDetectState>normal</packetOutDetectState>
+  </globalTrunkGroupStatus>
+  <globalTrunkGroupStatus>
+    <name>TG_LAB</name>
+    <addressContext>lab</addressContext>
+    <zone>ZONE_LAB</zone>
+    <state>outOfService</state>
+    <inboundCallsUsage>1</inboundCallsUsage>
+  </globalTrunkGroupStatus>
+</collection>`
+
+func TestTrunkGroupMetrics(t *testing.T) {
+	// The test SBC only answers the RESTCONF global trunk group status path
+	sbc, module := newTestSBC(t, map[string]string{
+		tgStatusPath: testGlobalTrunkGroupStatus,
+	})
+	registry := prometheus.NewRegistry()
+	if err := TrunkGroupMetrics(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("TrunkGroupMetrics() error = %v", err)
+	}
+
+	tg1 := map[string]string{"addresscontext": "default", "zone": "ZONE_A", "trunkgroup": "TG1"}
+	if v, _ := gatherValue(t, registry, "sonus_trunkgroup_inbound_calls_usage", tg1); v != 30 {
+		t.Errorf("sonus_trunkgroup_inbound_calls_usage of TG1 = %v, want 30", v)
+	}
+	if v, _ := gatherValue(t, registry, "sonus_trunkgroup_state", map[string]string{"trunkgroup": "TG1", "state": "inService"}); v != 1 {
+		t.Errorf("sonus_trunkgroup_state of TG1 in service = %v, want 1", v)
+	}
+	// The lab address context is not selected by the module
+	if _, ok := gatherValue(t, registry, "sonus_trunkgroup_inbound_calls_usage", map[string]string{"trunkgroup": "TG_LAB"}); ok {
+		t.Errorf("TrunkGroupMetrics() reported a trunk group of an address context that is not selected")
+	}
+}
+
+func TestTrunkGroupMetricsNotFound(t *testing.T) {
+	// An SBC without the global trunk group status fails the collector, as
+	// it only runs when a module lists it.
+	sbc, module := newTestSBC(t, nil)
+	err := TrunkGroupMetrics(context.Background(), sbc, module, prometheus.NewRegistry(), log.NewNopLogger())
+	if got := ErrorType(err); got != ErrorTypeNotFound {
+		t.Errorf("TrunkGroupMetrics() error = %v, want a %s error", err, ErrorTypeNotFound)
+	}
+}
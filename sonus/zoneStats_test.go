@@ -2,9 +2,11 @@ package sonus
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 func TestBuildMetrics(t *testing.T) {
@@ -15,7 +17,7 @@ func TestBuildMetrics(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want map[string]*prometheus.GaugeVec
+		want map[string]*MetricVec
 	}{
 		{
 			name: "Test metrics",
@@ -31,3 +33,46 @@ func TestBuildMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildMetricsTypes(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := BuildMetrics(registry, reflect.TypeOf(ZoneStats{}))
+	metrics["sonus_Zone_SipCurrentStatistics_RcvInvite"].Set(42, "sbc1", "default", "ZONE_A", "TG1")
+	metrics["sonus_Zone_SipSigPortStatistics_CallRate"].Set(3, "sbc1", "default", "ZONE_A", "")
+
+	expected := `
+# HELP sonus_Zone_SipCurrentStatistics_RcvInvite_total RcvInvite
+# TYPE sonus_Zone_SipCurrentStatistics_RcvInvite_total counter
+sonus_Zone_SipCurrentStatistics_RcvInvite_total{addresscontext="default",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 42
+# HELP sonus_Zone_SipSigPortStatistics_CallRate CallRate
+# TYPE sonus_Zone_SipSigPortStatistics_CallRate gauge
+sonus_Zone_SipSigPortStatistics_CallRate{addresscontext="default",system="sbc1",trunkgroup="",zone="ZONE_A"} 3
+`
+	err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_Zone_SipCurrentStatistics_RcvInvite_total", "sonus_Zone_SipSigPortStatistics_CallRate")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMetricStatesHelp(t *testing.T) {
+	for name := range metricStates {
+		if _, ok := metricHelp[name]; !ok {
+			t.Errorf("state-set metric %s has no help in metricHelp", name)
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, want := range map[string]string{
+		"InboundCPS":              "inbound_cps",
+		"CurrentPGRD":             "current_pgrd",
+		"EgressSustainedCallRate": "egress_sustained_call_rate",
+		"Tx503s":                  "tx503s",
+		"NumOfS8hrInboundRegSuc":  "num_of_s8hr_inbound_reg_suc",
+	} {
+		if got := snakeCase(name); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -23,51 +23,85 @@ type zoneStatus struct {
 	ActiveSipRegCount    float64 `xml:"http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0 activeSipRegCount"`
 }
 
-func ZoneProbe(ctx context.Context, sbc *SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error {
+func init() {
+	RegisterCollector("zones", ZoneProbe)
+}
+
+// ZoneProbe reports the statistics of the zones of every address context.  The
+// zone status responses are streamed, as they can be many megabytes on SBCs
+// with hundreds of trunk groups, and the metrics of each zone are set as soon
+// as it is parsed.
+func ZoneProbe(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
 	zoneStats := new(ZoneStats)
 	metrics := BuildMetrics(registry, reflect.TypeOf(zoneStats))
+	responses := newSIPResponseMetrics(registry)
+	tgInfo := newTrunkGroupInfo(registry, module.TrunkGroupInfo)
+	ipPeers := newIPPeerMetrics(registry)
+	sipConns := newSIPConnMetrics(registry, module.SipConnections)
+	qoe := newQoEMetrics(registry)
+	ars := newSIPArsMetrics(registry)
+	var kpis *kpiMetrics
+	if module.DerivedKPIs {
+		kpis = newKPIMetrics(registry)
+	}
 
 	g := &errgroup.Group{}
 
 	for _, aCtx := range sbc.AddressContexts.AddressContext {
+		aCtx := aCtx
 		g.Go(func() error {
-			aCtx := aCtx
-			stats := &ZoneStats{}
-			params := processStructParams{Context: aCtx.Name, Metrics: metrics, System: sbc.System}
-			err := sbc.GetAndParse(ctx, stats, zoneStatusPath, aCtx.Name)
-			if err != nil {
-				return err
-			}
-			for _, zone := range stats.Zone {
-				zTyp := getType(zone)
-				zVal := reflect.ValueOf(zone)
-				metricName := "sonus_Zone"
-				params.Zone = zone.Name
-				for z := 0; z < zTyp.NumField(); z++ {
-					zField := zTyp.Field(z)
-					zFieldType := zField.Type
-					switch zFieldType.Kind() {
-					case reflect.Struct:
-						params.MetricName = fmt.Sprintf("%s_%s", metricName, zField.Name)
-						processStruct(params, zVal.Field(z).Interface())
-					case reflect.Slice, reflect.Array:
-						if getType(zFieldType.Elem()).Kind() == reflect.Struct {
-							fieldSlice := reflect.ValueOf(zVal.Field(z).Interface())
-							for i := 0; i < fieldSlice.Len(); i++ {
-								params.MetricName = fmt.Sprintf("%s_%s", metricName, zField.Name)
-								processStruct(params, fieldSlice.Index(i).Interface())
-							}
-						}
-					}
+			params := processStructParams{Context: aCtx.Name, Metrics: metrics, System: sbc.System, logger: logger}
+			return sbc.StreamAndParse(ctx, "zone", func(decode func(v any) error) error {
+				zone := &Zone{}
+				if err := decode(zone); err != nil {
+					return err
 				}
-			}
-			return nil
+				processZone(params, zone)
+				responses.process(sbc.System, aCtx.Name, zone)
+				tgInfo.process(sbc.System, aCtx.Name, zone)
+				ipPeers.process(sbc.System, aCtx.Name, zone)
+				sipConns.process(sbc.System, aCtx.Name, zone)
+				qoe.process(sbc.System, aCtx.Name, zone)
+				ars.process(sbc.System, aCtx.Name, zone)
+				if kpis != nil {
+					kpis.process(sbc.System, aCtx.Name, zone)
+				}
+				return nil
+			}, zoneStatusPath, aCtx.Name)
 		})
 	}
 	return g.Wait()
 
 }
 
+// processZone sets the metrics of the statistics structures of the zone
+func processZone(params processStructParams, zone *Zone) {
+	zTyp := getType(zone)
+	zVal := reflect.ValueOf(zone).Elem()
+	metricName := "sonus_Zone"
+	params.Zone = zone.Name
+	for z := 0; z < zTyp.NumField(); z++ {
+		zField := zTyp.Field(z)
+		if skipMetric(zField) {
+			continue
+		}
+		zFieldType := zField.Type
+		switch zFieldType.Kind() {
+		case reflect.Struct:
+			params.MetricName = fmt.Sprintf("%s_%s", metricName, zField.Name)
+			processStruct(params, zVal.Field(z).Interface())
+		case reflect.Slice, reflect.Array:
+			if getType(zFieldType.Elem()).Kind() == reflect.Struct {
+				fieldSlice := reflect.ValueOf(zVal.Field(z).Interface())
+				for i := 0; i < fieldSlice.Len(); i++ {
+					params.MetricName = fmt.Sprintf("%s_%s", metricName, zField.Name)
+					processStruct(params, fieldSlice.Index(i).Interface())
+				}
+			}
+		}
+	}
+}
+
 func getType(i interface{}) reflect.Type {
 	typ := reflect.TypeOf(i)
 	if typ.Kind() == reflect.Pointer {
@@ -76,7 +110,7 @@ func getType(i interface{}) reflect.Type {
 	return typ
 }
 
-func ZoneMetrics(ctx context.Context, sbc *SBC, cfg *config.Config, registry *prometheus.Registry, logger log.Logger) error {
+func ZoneMetrics(ctx context.Context, sbc *SBC, module config.Module, registry *prometheus.Registry, logger log.Logger) error {
 	var (
 		Zone_Total_Calls_Configured = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: prometheus.BuildFQName("sonus", "zone", "total_calls_configured"),
@@ -95,8 +129,8 @@ func ZoneMetrics(ctx context.Context, sbc *SBC, cfg *config.Config, registry *pr
 	g := &errgroup.Group{}
 
 	for _, aCtx := range sbc.AddressContexts.AddressContext {
+		aCtx := aCtx
 		g.Go(func() error {
-			aCtx := aCtx
 			stats := &zonesStats{}
 			err := sbc.GetAndParse(ctx, stats, zoneStatusPath, aCtx.Name)
 			if err != nil {
@@ -1,20 +1,27 @@
 package sonus
 
 import (
+	"bytes"
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/prometheus/common/promlog"
 	"github.com/ringsq/sonus_exporter/config"
 )
 
 func TestZoneProbe(t *testing.T) {
+	requireSBC(t)
 	type args struct {
 		ctx      context.Context
 		sbc      *SBC
-		cfg      *config.Config
+		module   config.Module
 		registry *prometheus.Registry
 		logger   log.Logger
 	}
@@ -28,7 +35,7 @@ func TestZoneProbe(t *testing.T) {
 			args: args{
 				ctx:      context.Background(),
 				sbc:      testSBC,
-				cfg:      &config.Config{},
+				module:   config.DefaultModule,
 				registry: prometheus.NewRegistry(),
 				logger:   promlog.New(&promlog.Config{}),
 			},
@@ -36,9 +43,168 @@ func TestZoneProbe(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := ZoneProbe(tt.args.ctx, tt.args.sbc, tt.args.cfg, tt.args.registry, tt.args.logger); (err != nil) != tt.wantErr {
+			if err := ZoneProbe(tt.args.ctx, tt.args.sbc, tt.args.module, tt.args.registry, tt.args.logger); (err != nil) != tt.wantErr {
 				t.Errorf("ZoneProbe() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+const testZoneStatus = `<collection xmlns:y="http://tail-f.com/ns/rest">
+  <zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+    <name>ZONE_A</name>
+    <sipSigPortStatistics>
+      <callRate>3</callRate>
+      <origCalls>120</origCalls>
+    </sipSigPortStatistics>
+  </zone>
+  <zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+    <name>ZONE_B</name>
+    <sipSigPortStatistics>
+      <callRate>7</callRate>
+    </sipSigPortStatistics>
+  </zone>
+</collection>`
+
+func TestZoneProbeStream(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/admin"):
+			w.Write([]byte(testSystemAdmin))
+		case strings.HasSuffix(r.URL.Path, "sonusZone:zone"):
+			w.Write([]byte(testZoneStatus))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	module := config.DefaultModule
+	module.AddressContexts.Discovery = false
+	sbc, err := NewSBC(context.Background(), strings.TrimPrefix(srv.URL, "https://"), "user", "password", module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	if err := ZoneProbe(context.Background(), sbc, module, registry, log.NewNopLogger()); err != nil {
+		t.Fatalf("ZoneProbe() error = %v", err)
+	}
+
+	expected := `
+# HELP sonus_Zone_SipSigPortStatistics_CallRate CallRate
+# TYPE sonus_Zone_SipSigPortStatistics_CallRate gauge
+sonus_Zone_SipSigPortStatistics_CallRate{addresscontext="default",system="testsbc",trunkgroup="",zone="ZONE_A"} 3
+sonus_Zone_SipSigPortStatistics_CallRate{addresscontext="default",system="testsbc",trunkgroup="",zone="ZONE_B"} 7
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "sonus_Zone_SipSigPortStatistics_CallRate"); err != nil {
+		t.Error(err)
+	}
+
+	// The zone status response is larger than the maximum body size
+	sbc.maxBodySize = 256
+	err = ZoneProbe(context.Background(), sbc, module, prometheus.NewRegistry(), log.NewNopLogger())
+	if got := ErrorType(err); got != ErrorTypeBodySize {
+		t.Errorf("ErrorType() of oversized response = %q, want %q", got, ErrorTypeBodySize)
+	}
+}
+
+func TestProcessZoneIntervals(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <callIntervalStatistics>
+    <number>1</number>
+    <name>TG1</name>
+    <intervalValid>true</intervalValid>
+    <time>1000</time>
+    <inCalls>10</inCalls>
+  </callIntervalStatistics>
+  <callIntervalStatistics>
+    <number>2</number>
+    <name>TG1</name>
+    <intervalValid>true</intervalValid>
+    <time>2000</time>
+    <inCalls>20</inCalls>
+  </callIntervalStatistics>
+  <callIntervalStatistics>
+    <number>3</number>
+    <name>TG1</name>
+    <intervalValid>false</intervalValid>
+    <time>3000</time>
+    <inCalls>5</inCalls>
+  </callIntervalStatistics>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	metrics := BuildMetrics(registry, reflect.TypeOf(ZoneStats{}))
+	processZone(processStructParams{Context: "default", Metrics: metrics, System: "sbc1", logger: log.NewNopLogger()}, zone)
+
+	expected := `
+# HELP sonus_Zone_CallIntervalStatistics_InCalls The current number of completed inbound calls on this trunk group.
+# TYPE sonus_Zone_CallIntervalStatistics_InCalls gauge
+sonus_Zone_CallIntervalStatistics_InCalls{addresscontext="default",interval="1",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 10
+sonus_Zone_CallIntervalStatistics_InCalls{addresscontext="default",interval="2",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 20
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "sonus_Zone_CallIntervalStatistics_InCalls"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessZoneStates(t *testing.T) {
+	const zoneXML = `<zone xmlns="http://sonusnet.com/ns/mibs/SONUS-ZONE/1.0">
+  <name>ZONE_A</name>
+  <sipSigPortStatus>
+    <index>1</index>
+    <state>pending</state>
+  </sipSigPortStatus>
+  <sipTrunkGroup>
+    <name>TG1</name>
+    <state>enabled</state>
+    <mode>outOfService</mode>
+  </sipTrunkGroup>
+  <trunkGroupStatus>
+    <name>TG1</name>
+    <state>blocked</state>
+    <packetOutDetectState>normal</packetOutDetectState>
+  </trunkGroupStatus>
+</zone>`
+	zone := &Zone{}
+	if err := (xmlDecoder{}).Decode([]byte(zoneXML), zone); err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	metrics := BuildMetrics(registry, reflect.TypeOf(ZoneStats{}))
+	var logs bytes.Buffer
+	processZone(processStructParams{Context: "default", Metrics: metrics, System: "sbc1", logger: log.NewLogfmtLogger(&logs)}, zone)
+
+	expected := `
+# HELP sonus_Zone_SipSigPortStatus_State Service state of the SIP signaling port of the zone, 1 for the current state.
+# TYPE sonus_Zone_SipSigPortStatus_State gauge
+sonus_Zone_SipSigPortStatus_State{addresscontext="default",state="inService",system="sbc1",trunkgroup="",zone="ZONE_A"} 0
+sonus_Zone_SipSigPortStatus_State{addresscontext="default",state="outOfService",system="sbc1",trunkgroup="",zone="ZONE_A"} 0
+sonus_Zone_SipSigPortStatus_State{addresscontext="default",state="unknown",system="sbc1",trunkgroup="",zone="ZONE_A"} 1
+# HELP sonus_Zone_SipTrunkGroup_Mode Operational mode of the SIP trunk group, 1 for the current mode.
+# TYPE sonus_Zone_SipTrunkGroup_Mode gauge
+sonus_Zone_SipTrunkGroup_Mode{addresscontext="default",state="inService",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_Zone_SipTrunkGroup_Mode{addresscontext="default",state="outOfService",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1
+sonus_Zone_SipTrunkGroup_Mode{addresscontext="default",state="unknown",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+# HELP sonus_Zone_TrunkGroupStatus_State Service state of the trunk group, 1 for the current state.
+# TYPE sonus_Zone_TrunkGroupStatus_State gauge
+sonus_Zone_TrunkGroupStatus_State{addresscontext="default",state="inService",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_Zone_TrunkGroupStatus_State{addresscontext="default",state="outOfService",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 0
+sonus_Zone_TrunkGroupStatus_State{addresscontext="default",state="unknown",system="sbc1",trunkgroup="TG1",zone="ZONE_A"} 1
+`
+	err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"sonus_Zone_SipSigPortStatus_State", "sonus_Zone_SipTrunkGroup_Mode", "sonus_Zone_TrunkGroupStatus_State")
+	if err != nil {
+		t.Error(err)
+	}
+	// The unknown values are logged, as the metrics do not show them
+	for _, want := range []string{"state=pending", "state=blocked"} {
+		if !strings.Contains(logs.String(), want) {
+			t.Errorf("log does not contain %q:\n%s", want, logs.String())
+		}
+	}
+}